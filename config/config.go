@@ -2,14 +2,33 @@ package config
 
 import (
 	"fmt"
+	"net"
 	"net/http"
 	"net/url"
+	"reflect"
 	"strconv"
 	"strings"
 )
 
 type Config struct {
 	Servers []ServerConfig
+
+	// Sections that discover servers at runtime (e.g. [discover_rds]), whose settings
+	// are used as a template for each discovered server
+	Discovery []ServerConfig
+
+	// Address (e.g. "127.0.0.1:8080") for the local HTTP server that provides the
+	// /healthz, /readyz and /status endpoints - only read from the [pganalyze] section
+	StatusListenAddress string
+
+	// Limits for how many snapshots run at the same time across all servers (0 = no limit),
+	// and the soft memory budget after which full snapshots are deferred (0 = disabled) -
+	// only read from the [pganalyze] section
+	MaxConcurrency                   int
+	MaxConcurrentFullSnapshots       int
+	MaxConcurrentActivitySnapshots   int
+	MaxConcurrentQueryStatsSnapshots int
+	MemoryBudgetMB                   int
 }
 
 type ServerIdentifier struct {
@@ -31,15 +50,23 @@ type ServerConfig struct {
 	ErrorCallback   string `ini:"error_callback"`
 	SuccessCallback string `ini:"success_callback"`
 
-	EnableReports    bool `ini:"enable_reports"`
-	DisableLogs      bool `ini:"disable_logs"`
-	DisableActivity  bool `ini:"disable_activity"`
-	EnableLogExplain bool `ini:"enable_log_explain"`
+	WebhookURL              string `ini:"webhook_url"`
+	WebhookHeaders          string `ini:"webhook_headers"`
+	WebhookSecret           string `ini:"webhook_secret"`
+	WebhookRetries          int    `ini:"webhook_retries"`
+	WebhookStateChangesOnly bool   `ini:"webhook_state_changes_only"`
+
+	EnableReports      bool `ini:"enable_reports"`
+	DisableLogs        bool `ini:"disable_logs"`
+	DisableActivity    bool `ini:"disable_activity"`
+	EnableLogExplain   bool `ini:"enable_log_explain"`
+	EnableSecurityInfo bool `ini:"enable_security_info"`
 
 	DbURL                 string `ini:"db_url"`
 	DbName                string `ini:"db_name"`
 	DbUsername            string `ini:"db_username"`
 	DbPassword            string `ini:"db_password"`
+	DbPasswordFile        string `ini:"db_password_file"` // Read into db_password if that is not set
 	DbHost                string `ini:"db_host"`
 	DbPort                int    `ini:"db_port"`
 	DbSslMode             string `ini:"db_sslmode"`
@@ -50,6 +77,20 @@ type ServerConfig struct {
 	DbSslKey              string `ini:"db_sslkey"`
 	DbSslKeyContents      string `ini:"db_sslkey_contents"`
 
+	// High availability clusters (e.g. managed by Patroni): Instead of db_host and db_port,
+	// either list all cluster members ("host1:5432,host2:5432"), the Patroni REST API of one
+	// or more members ("http://host1:8008"), or the Consul or etcd (v3) HTTP API that Patroni
+	// stores the cluster in, together with the Patroni scope (cluster name) and namespace
+	// (defaults to "/service"). The collector connects to the current primary (or a replica,
+	// if db_ha_follow is "replica"), and follows role changes.
+	DbHAMembers          string `ini:"db_ha_members"`
+	DbHAPatroniURL       string `ini:"db_ha_patroni_url"`
+	DbHAConsulURL        string `ini:"db_ha_consul_url"`
+	DbHAEtcdURL          string `ini:"db_ha_etcd_url"`
+	DbHAPatroniScope     string `ini:"db_ha_patroni_scope"`
+	DbHAPatroniNamespace string `ini:"db_ha_patroni_namespace"`
+	DbHAFollow           string `ini:"db_ha_follow"`
+
 	// We have to do some tricks to support sslmode=prefer, namely we have to
 	// first try an SSL connection (= require), and if that fails change the
 	// sslmode to none
@@ -64,6 +105,23 @@ type ServerConfig struct {
 	AwsSecretAccessKey string `ini:"aws_secret_access_key"`
 	AwsAssumeRole      string `ini:"aws_assume_role"`
 
+	// Tags that instances (or their Aurora cluster) need to have to be monitored, when
+	// used in a [discover_rds] section, e.g. "env=production,pganalyze" (a tag without
+	// a value only needs to exist)
+	DiscoverRdsTags string `ini:"discover_rds_tags"`
+
+	// Settings for [discover_kubernetes] sections, that find Postgres instances through the
+	// Kubernetes API. The kind is either "service" (default), "pod", or the custom resource of an
+	// operator ("cloudnativepg", "zalando" or "crunchydata"). The namespace defaults to the
+	// one the collector runs in, "*" searches all namespaces. The secret (with placeholders
+	// like "{name}-monitoring") provides the credentials, and defaults to the secret the
+	// operator creates for the cluster.
+	DiscoverKubernetesKind          string `ini:"discover_kubernetes_kind"`
+	DiscoverKubernetesNamespace     string `ini:"discover_kubernetes_namespace"`
+	DiscoverKubernetesLabelSelector string `ini:"discover_kubernetes_label_selector"`
+	DiscoverKubernetesSecret        string `ini:"discover_kubernetes_secret"`
+	DiscoverKubernetesAPIURL        string `ini:"discover_kubernetes_api_url"`
+
 	// Support for custom AWS endpoints
 	// See https://docs.aws.amazon.com/sdk-for-go/api/aws/endpoints/
 	AwsEndpointSigningRegion       string `ini:"aws_endpoint_signing_region"`
@@ -136,10 +194,40 @@ type ServerConfig struct {
 	// the collector multiple times against the same database server
 	MaxCollectorConnections int `ini:"max_collector_connections"`
 
+	// Enables the "bloat_exact" report, which measures bloat using the pgstattuple
+	// extension instead of estimating it
+	//
+	// Relations larger than bloat_exact_max_size_mb (defaults to 10 GB), or that
+	// take longer than bloat_exact_timeout_ms to measure (defaults to 30 seconds),
+	// use the estimate instead
+	EnableBloatExact    bool `ini:"enable_bloat_exact"`
+	BloatExactMaxSizeMb int  `ini:"bloat_exact_max_size_mb"`
+	BloatExactTimeoutMs int  `ini:"bloat_exact_timeout_ms"`
+
+	// Runs a generic EXPLAIN (without parameter values) for the statements with the
+	// highest total time on each full snapshot, which gives plans for queries that
+	// don't show up in the Postgres log
+	//
+	// Before Postgres 12 the statement is prepared and EXPLAIN EXECUTEd with NULL values
+	// until Postgres switches to a generic plan, which doesn't happen for all queries
+	//
+	// generic_explain_top_n sets the number of statements (defaults to 10)
+	EnableGenericExplain bool `ini:"enable_generic_explain"`
+	GenericExplainTopN   int  `ini:"generic_explain_top_n"`
+
+	// Runs the given reports (comma separated, e.g. "bloat,sequence") every hour, and
+	// writes their results to local_reports_directory, without requiring the pganalyze
+	// web app to request them
+	//
+	// local_reports_format is one of csv (default), table or json
+	LocalReports          string `ini:"local_reports"`
+	LocalReportsDirectory string `ini:"local_reports_directory"`
+	LocalReportsFormat    string `ini:"local_reports_format"`
+
 	// Configuration for PII filtering
 	FilterLogSecret   string `ini:"filter_log_secret"`   // none/all/credential/parsing_error/statement_text/statement_parameter/table_data/ops/unidentified (comma separated)
 	FilterQuerySample string `ini:"filter_query_sample"` // none/all (defaults to "none")
-	FilterQueryText   string `ini:"filter_query_text"`   // none/unparsable (defaults to "unparsable")
+	FilterQueryText   string `ini:"filter_query_text"`   // none/unparsable/normalize (defaults to "unparsable")
 
 	// HTTP proxy overrides
 	HTTPProxy  string `ini:"http_proxy"`
@@ -282,6 +370,50 @@ func (config ServerConfig) GetPqOpenString(dbNameOverride string) string {
 	return strings.Join(dbinfo, " ")
 }
 
+// Equal - Whether two server configurations are the same, ignoring state that is only
+// determined at runtime (e.g. the HTTP client, or the sslmode=prefer fallback)
+func (config ServerConfig) Equal(other ServerConfig) bool {
+	for _, c := range []*ServerConfig{&config, &other} {
+		c.HTTPClient = nil
+		c.DbSslModePreferFailed = false
+
+		// Certificates passed as contents are written to a new temporary file on every read
+		if c.DbSslRootCertContents != "" {
+			c.DbSslRootCert = ""
+		}
+		if c.DbSslCertContents != "" {
+			c.DbSslCert = ""
+		}
+		if c.DbSslKeyContents != "" {
+			c.DbSslKey = ""
+		}
+	}
+	return reflect.DeepEqual(config, other)
+}
+
+// HAEnabled - Whether the server is a high availability cluster, whose members are
+// determined at runtime
+func (config ServerConfig) HAEnabled() bool {
+	return config.DbHAMembers != "" || config.DbHAPatroniURL != "" || config.DbHAConsulURL != "" || config.DbHAEtcdURL != ""
+}
+
+// GetWebhookHeaders - Parses the additional HTTP headers sent with webhooks, which are
+// specified as a comma-separated list of "Name: Value" pairs
+func (config ServerConfig) GetWebhookHeaders() (map[string]string, error) {
+	headers := make(map[string]string)
+	if config.WebhookHeaders == "" {
+		return headers, nil
+	}
+	for _, header := range strings.Split(config.WebhookHeaders, ",") {
+		parts := strings.SplitN(header, ":", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, fmt.Errorf("invalid header \"%s\", expected \"Name: Value\"", strings.TrimSpace(header))
+		}
+		headers[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+	return headers, nil
+}
+
 // GetDbHost - Gets the database hostname from the given configuration
 func (config ServerConfig) GetDbHost() string {
 	if config.DbURL != "" {
@@ -322,6 +454,55 @@ func (config ServerConfig) GetDbUsername() string {
 	return config.DbUsername
 }
 
+// GetConnectionCredentials - Gets the username and password used for connecting,
+// with db_username and db_password taking precedence over db_url like in GetPqOpenString
+func (config ServerConfig) GetConnectionCredentials() (username string, password string) {
+	if config.DbURL != "" {
+		u, _ := url.Parse(config.DbURL)
+		if u != nil && u.User != nil {
+			username = u.User.Username()
+			password, _ = u.User.Password()
+		}
+	}
+	if config.DbUsername != "" {
+		username = config.DbUsername
+	}
+	if config.DbPassword != "" {
+		password = config.DbPassword
+	}
+	return
+}
+
+// WithCredentials - Returns a copy of the configuration that connects using the given
+// username and password instead, removing any credentials from db_url
+func (config ServerConfig) WithCredentials(username string, password string) ServerConfig {
+	if config.DbURL != "" {
+		u, err := url.Parse(config.DbURL)
+		if err == nil {
+			u.User = nil
+			config.DbURL = u.String()
+		}
+	}
+	config.DbUsername = username
+	config.DbPassword = password
+	return config
+}
+
+// WithHost - Returns a copy of the configuration that connects to the given host and
+// port instead, replacing the host in db_url (if set)
+func (config ServerConfig) WithHost(host string, port int) ServerConfig {
+	if config.DbURL != "" {
+		u, err := url.Parse(config.DbURL)
+		if err == nil {
+			u.Host = net.JoinHostPort(host, strconv.Itoa(port))
+			config.DbURL = u.String()
+		}
+	}
+	config.DbHost = host
+	config.DbPort = port
+	return config
+}
+
 // GetDbName - Gets the database name from the given configuration
 func (config ServerConfig) GetDbName() string {
 	if config.DbURL != "" {
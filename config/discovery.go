@@ -0,0 +1,114 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// IsDiscoverySection - Whether the config section discovers servers at runtime, instead
+// of describing a single server (e.g. [discover_rds] or [discover_kubernetes_production])
+func IsDiscoverySection(sectionName string) bool {
+	return IsRdsDiscoverySection(sectionName) || IsKubernetesDiscoverySection(sectionName)
+}
+
+// IsRdsDiscoverySection - Whether the config section discovers Amazon RDS instances
+func IsRdsDiscoverySection(sectionName string) bool {
+	return strings.HasPrefix(sectionName, "discover_rds")
+}
+
+// IsKubernetesDiscoverySection - Whether the config section discovers Postgres instances
+// through the Kubernetes API
+func IsKubernetesDiscoverySection(sectionName string) bool {
+	return strings.HasPrefix(sectionName, "discover_kubernetes")
+}
+
+// DiscoveredInstance - Database instance found by a discovery section
+//
+// For Kubernetes, Tags contains the labels of the resource, and the database settings
+// are read from the secret (they are only used when not set in the discovery section).
+type DiscoveredInstance struct {
+	ID        string
+	ClusterID string
+	Namespace string
+	Region    string
+	Host      string
+	Port      int
+	Tags      map[string]string
+
+	// Identifies the instance instead of its host, if the host can change (e.g. the IP of a pod)
+	SystemID string
+
+	DbName     string
+	DbUsername string
+	DbPassword string
+}
+
+// DiscoveredServerConfig - Returns the configuration of a discovered server, based on the
+// settings of the discovery section
+//
+// In db_name, db_username, db_password and db_password_file, "{instance_id}",
+// "{cluster_id}", "{namespace}", "{region}" and "{tag:<name>}" are replaced with the
+// values of the instance.
+func DiscoveredServerConfig(discovery ServerConfig, instance DiscoveredInstance) (ServerConfig, error) {
+	config := &ServerConfig{}
+	*config = discovery
+
+	replacements := []string{
+		"{instance_id}", instance.ID,
+		"{cluster_id}", instance.ClusterID,
+		"{namespace}", instance.Namespace,
+		"{region}", instance.Region,
+	}
+	for key, value := range instance.Tags {
+		replacements = append(replacements, "{tag:"+key+"}", value)
+	}
+	replacer := strings.NewReplacer(replacements...)
+
+	config.DbName = replacer.Replace(config.DbName)
+	config.DbUsername = replacer.Replace(config.DbUsername)
+	config.DbPassword = replacer.Replace(config.DbPassword)
+	config.DbPasswordFile = replacer.Replace(config.DbPasswordFile)
+	if config.DbName == "" {
+		config.DbName = instance.DbName
+	}
+	if config.DbUsername == "" {
+		config.DbUsername = instance.DbUsername
+	}
+	if config.DbPassword == "" && config.DbPasswordFile == "" {
+		config.DbPassword = instance.DbPassword
+	}
+	config.DbHost = instance.Host
+	config.DbPort = instance.Port
+	config.DbURL = ""
+	if instance.Region != "" {
+		config.AwsDbInstanceID = instance.ID
+		config.AwsRegion = instance.Region
+	}
+	if config.DbName == "" {
+		config.DbName = "postgres"
+	}
+
+	name := instance.ID
+	if instance.Namespace != "" {
+		name = instance.Namespace + "/" + instance.ID
+	}
+
+	config, err := preprocessConfig(config)
+	if err != nil {
+		return ServerConfig{}, fmt.Errorf("%s: %s", name, err)
+	}
+	config.SectionName = discovery.SectionName + "/" + name
+	config.SystemType, config.SystemScope, config.SystemID = identifySystem(*config)
+	if instance.SystemID != "" && discovery.SystemID == "" {
+		config.SystemID = instance.SystemID
+	}
+	config.Identifier = ServerIdentifier{
+		APIKey:      config.APIKey,
+		APIBaseURL:  config.APIBaseURL,
+		SystemID:    config.SystemID,
+		SystemType:  config.SystemType,
+		SystemScope: config.SystemScope,
+	}
+
+	return *config, nil
+}
@@ -37,7 +37,11 @@ func identifySystem(config ServerConfig) (systemType string, systemScope string,
 		systemType = "self_hosted"
 		if systemID == "" {
 			hostname := config.GetDbHost()
-			if hostname == "" || hostname == "localhost" || hostname == "127.0.0.1" {
+			if config.HAEnabled() {
+				// The members of a high availability cluster change roles, so the
+				// section identifies the cluster instead
+				hostname = config.SectionName
+			} else if hostname == "" || hostname == "localhost" || hostname == "127.0.0.1" {
 				hostname, _ = os.Hostname()
 			}
 			systemID = hostname
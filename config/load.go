@@ -0,0 +1,175 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/go-ini/ini"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// loadConfigFile - Loads the INI or YAML config file, together with all config files in
+// the include_dir directory (if set in the [pganalyze] section or the YAML defaults)
+func loadConfigFile(filename string) (*ini.File, error) {
+	source, err := configSource(filename)
+	if err != nil {
+		return nil, err
+	}
+	configFile, err := ini.Load(source)
+	if err != nil {
+		return nil, err
+	}
+
+	includeDir := configFile.Section("pganalyze").Key("include_dir").String()
+	if includeDir == "" {
+		return configFile, nil
+	}
+	if !filepath.IsAbs(includeDir) {
+		includeDir = filepath.Join(filepath.Dir(filename), includeDir)
+	}
+
+	var includeFiles []string
+	for _, pattern := range []string{"*.conf", "*.ini", "*.yml", "*.yaml"} {
+		matches, err := filepath.Glob(filepath.Join(includeDir, pattern))
+		if err != nil {
+			return nil, err
+		}
+		includeFiles = append(includeFiles, matches...)
+	}
+	sort.Strings(includeFiles)
+
+	// Remember where each section was defined, so a server defined twice gets reported,
+	// instead of silently merging the settings of both definitions
+	sectionFiles := make(map[string]string)
+	for _, name := range configFile.SectionStrings() {
+		sectionFiles[name] = filename
+	}
+
+	for _, includeFile := range includeFiles {
+		source, err = configSource(includeFile)
+		if err != nil {
+			return nil, err
+		}
+		includeConfig, err := ini.Load(source)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", includeFile, err)
+		}
+		for _, name := range includeConfig.SectionStrings() {
+			if name == ini.DEFAULT_SECTION {
+				continue
+			}
+			if name == "pganalyze" {
+				return nil, fmt.Errorf("%s: the [pganalyze] section (or YAML defaults) can only be set in the main config file", includeFile)
+			}
+			if otherFile, ok := sectionFiles[name]; ok {
+				return nil, fmt.Errorf("%s: section [%s] is already defined in %s", includeFile, name, otherFile)
+			}
+			sectionFiles[name] = includeFile
+		}
+		err = configFile.Append(source)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", includeFile, err)
+		}
+	}
+
+	return configFile, nil
+}
+
+// configSource - Returns the config file as a source for go-ini, converting YAML to INI
+func configSource(filename string) (interface{}, error) {
+	if !isYAMLFile(filename) {
+		return filename, nil
+	}
+
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	iniData, err := yamlToIni(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", filename, err)
+	}
+	return iniData, nil
+}
+
+func isYAMLFile(filename string) bool {
+	ext := strings.ToLower(filepath.Ext(filename))
+	return ext == ".yml" || ext == ".yaml"
+}
+
+type yamlConfig struct {
+	Defaults yaml.MapSlice `yaml:"defaults"`
+	Servers  yaml.MapSlice `yaml:"servers"`
+}
+
+// yamlToIni - Converts a YAML config file to the equivalent INI file
+//
+// The "defaults" block becomes the [pganalyze] section, whose settings are inherited by
+// all servers, and each entry in "servers" becomes a section using the same setting names.
+func yamlToIni(data []byte) ([]byte, error) {
+	var conf yamlConfig
+	err := yaml.UnmarshalStrict(data, &conf)
+	if err != nil {
+		return nil, err
+	}
+
+	iniFile := ini.Empty()
+	err = addYAMLSection(iniFile, "pganalyze", conf.Defaults)
+	if err != nil {
+		return nil, err
+	}
+	for _, server := range conf.Servers {
+		settings, ok := server.Value.(yaml.MapSlice)
+		if !ok {
+			return nil, fmt.Errorf("server \"%v\" needs to be a map of settings", server.Key)
+		}
+		err = addYAMLSection(iniFile, fmt.Sprint(server.Key), settings)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var out bytes.Buffer
+	_, err = iniFile.WriteTo(&out)
+	if err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+func addYAMLSection(iniFile *ini.File, name string, settings yaml.MapSlice) error {
+	if len(settings) == 0 {
+		return nil
+	}
+
+	section, err := iniFile.NewSection(name)
+	if err != nil {
+		return err
+	}
+	for _, setting := range settings {
+		var value string
+		switch v := setting.Value.(type) {
+		case nil:
+			value = ""
+		case []interface{}: // e.g. a list of database names for db_name
+			var values []string
+			for _, item := range v {
+				values = append(values, fmt.Sprint(item))
+			}
+			value = strings.Join(values, ",")
+		case yaml.MapSlice:
+			return fmt.Errorf("%s: setting \"%v\" can't be a map", name, setting.Key)
+		default:
+			value = fmt.Sprint(v)
+		}
+		_, err = section.NewKey(fmt.Sprint(setting.Key), value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-ini/ini"
+	"github.com/kylelemons/godebug/pretty"
+)
+
+// iniSections - Returns all settings of the INI file by section, for easier comparison
+func iniSections(configFile *ini.File) map[string]map[string]string {
+	sections := make(map[string]map[string]string)
+	for _, section := range configFile.Sections() {
+		if section.Name() == ini.DEFAULT_SECTION && len(section.Keys()) == 0 {
+			continue
+		}
+		sections[section.Name()] = section.KeysHash()
+	}
+	return sections
+}
+
+var yamlToIniTests = []struct {
+	input       string
+	expected    map[string]map[string]string
+	expectedErr string
+}{
+	{
+		`
+defaults:
+  api_key: abc
+  include_dir: /etc/pganalyze-collector.d/
+servers:
+  primary:
+    db_host: primary.internal
+    db_port: 5432
+    db_name: [app, reporting]
+  replica:
+    db_host: replica.internal
+    db_password:
+`,
+		map[string]map[string]string{
+			"pganalyze": {"api_key": "abc", "include_dir": "/etc/pganalyze-collector.d/"},
+			"primary":   {"db_host": "primary.internal", "db_port": "5432", "db_name": "app,reporting"},
+			"replica":   {"db_host": "replica.internal", "db_password": ""},
+		},
+		"",
+	},
+	// Settings are only inherited from the defaults when reading the config, not here
+	{
+		`
+servers:
+  server1:
+    db_host: localhost
+`,
+		map[string]map[string]string{
+			"server1": {"db_host": "localhost"},
+		},
+		"",
+	},
+	{
+		`
+servers:
+  server1:
+    db_host:
+      name: localhost
+`,
+		nil,
+		`server1: setting "db_host" can't be a map`,
+	},
+	{
+		`
+servers:
+  server1: localhost
+`,
+		nil,
+		`server "server1" needs to be a map of settings`,
+	},
+	// Unknown top-level keys are likely typos
+	{
+		`
+server:
+  server1:
+    db_host: localhost
+`,
+		nil,
+		"field server not found",
+	},
+}
+
+func TestYamlToIni(t *testing.T) {
+	for _, test := range yamlToIniTests {
+		data, err := yamlToIni([]byte(test.input))
+		if test.expectedErr != "" {
+			if err == nil || !strings.Contains(err.Error(), test.expectedErr) {
+				t.Errorf("yamlToIni(%q): expected error %q, got %v", test.input, test.expectedErr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("yamlToIni(%q): unexpected error %s", test.input, err)
+			continue
+		}
+
+		configFile, err := ini.Load(data)
+		if err != nil {
+			t.Errorf("yamlToIni(%q): output is not a valid INI file: %s", test.input, err)
+			continue
+		}
+		if diff := pretty.Compare(test.expected, iniSections(configFile)); diff != "" {
+			t.Errorf("yamlToIni(%q): diff: (-want +got)\n%s", test.input, diff)
+		}
+	}
+}
+
+var loadConfigFileTests = []struct {
+	name        string
+	files       map[string]string // Relative to the temporary directory, "main.conf" is loaded
+	expected    map[string]map[string]string
+	expectedErr string
+}{
+	{
+		"include_dir with INI and YAML files",
+		map[string]string{
+			"main.conf":        "[pganalyze]\napi_key = abc\ninclude_dir = conf.d\n\n[server1]\ndb_host = host1\n",
+			"conf.d/a.conf":    "[server2]\ndb_host = host2\n",
+			"conf.d/b.yml":     "servers:\n  server3:\n    db_host: host3\n    db_name: [app, reporting]\n",
+			"conf.d/notes.txt": "[ignored]\ndb_host = ignored\n",
+		},
+		map[string]map[string]string{
+			"pganalyze": {"api_key": "abc", "include_dir": "conf.d"},
+			"server1":   {"db_host": "host1"},
+			"server2":   {"db_host": "host2"},
+			"server3":   {"db_host": "host3", "db_name": "app,reporting"},
+		},
+		"",
+	},
+	{
+		"YAML main file",
+		map[string]string{
+			"main.yml":      "defaults:\n  api_key: abc\n  include_dir: conf.d\n",
+			"conf.d/a.conf": "[server1]\ndb_host = host1\n",
+		},
+		map[string]map[string]string{
+			"pganalyze": {"api_key": "abc", "include_dir": "conf.d"},
+			"server1":   {"db_host": "host1"},
+		},
+		"",
+	},
+	{
+		"[pganalyze] section in included file",
+		map[string]string{
+			"main.conf":     "[pganalyze]\napi_key = abc\ninclude_dir = conf.d\n",
+			"conf.d/a.conf": "[pganalyze]\napi_key = other\n\n[server1]\ndb_host = host1\n",
+		},
+		nil,
+		"a.conf: the [pganalyze] section (or YAML defaults) can only be set in the main config file",
+	},
+	{
+		"YAML defaults in included file",
+		map[string]string{
+			"main.conf":    "[pganalyze]\napi_key = abc\ninclude_dir = conf.d\n",
+			"conf.d/a.yml": "defaults:\n  api_key: other\n",
+		},
+		nil,
+		"a.yml: the [pganalyze] section (or YAML defaults) can only be set in the main config file",
+	},
+	{
+		"duplicate section across included files",
+		map[string]string{
+			"main.conf":     "[pganalyze]\napi_key = abc\ninclude_dir = conf.d\n",
+			"conf.d/a.conf": "[server1]\ndb_host = host1\n",
+			"conf.d/b.yml":  "servers:\n  server1:\n    db_host: host2\n",
+		},
+		nil,
+		"b.yml: section [server1] is already defined in",
+	},
+	{
+		"duplicate section in main and included file",
+		map[string]string{
+			"main.conf":     "[pganalyze]\napi_key = abc\ninclude_dir = conf.d\n\n[server1]\ndb_host = host1\n",
+			"conf.d/a.conf": "[server1]\ndb_name = app\n",
+		},
+		nil,
+		"a.conf: section [server1] is already defined in",
+	},
+}
+
+func TestLoadConfigFile(t *testing.T) {
+	for _, test := range loadConfigFileTests {
+		dir, err := ioutil.TempDir("", "pganalyze_collector_test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		mainFile := ""
+		for name, content := range test.files {
+			path := filepath.Join(dir, name)
+			os.MkdirAll(filepath.Dir(path), 0755)
+			err = ioutil.WriteFile(path, []byte(content), 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(name, "/") {
+				mainFile = path
+			}
+		}
+
+		configFile, err := loadConfigFile(mainFile)
+		os.RemoveAll(dir)
+		if test.expectedErr != "" {
+			if err == nil || !strings.Contains(err.Error(), test.expectedErr) {
+				t.Errorf("%s: expected error %q, got %v", test.name, test.expectedErr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error %s", test.name, err)
+			continue
+		}
+		if diff := pretty.Compare(test.expected, iniSections(configFile)); diff != "" {
+			t.Errorf("%s: diff: (-want +got)\n%s", test.name, diff)
+		}
+	}
+}
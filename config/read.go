@@ -13,7 +13,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/go-ini/ini"
 	"golang.org/x/net/http/httpproxy"
 
 	"github.com/pganalyze/collector/util"
@@ -21,12 +20,19 @@ import (
 
 const defaultAPIBaseURL = "https://api.pganalyze.com"
 
+const defaultMaxConcurrency = 50
+
 func getDefaultConfig() *ServerConfig {
 	config := &ServerConfig{
 		APIBaseURL:              defaultAPIBaseURL,
 		SectionName:             "default",
 		QueryStatsInterval:      60,
 		MaxCollectorConnections: 10,
+		BloatExactMaxSizeMb:     10240,
+		BloatExactTimeoutMs:     30000,
+		GenericExplainTopN:      10,
+		LocalReportsFormat:      "csv",
+		WebhookRetries:          3,
 	}
 
 	// The environment variables are the default way to configure when running inside a Docker container.
@@ -57,6 +63,9 @@ func getDefaultConfig() *ServerConfig {
 	if enableLogExplain := os.Getenv("PGA_ENABLE_LOG_EXPLAIN"); enableLogExplain != "" && enableLogExplain != "0" {
 		config.EnableLogExplain = true
 	}
+	if enableSecurityInfo := os.Getenv("PGA_ENABLE_SECURITY_INFO"); enableSecurityInfo != "" && enableSecurityInfo != "0" {
+		config.EnableSecurityInfo = true
+	}
 	if dbURL := os.Getenv("DB_URL"); dbURL != "" {
 		config.DbURL = dbURL
 	}
@@ -185,6 +194,30 @@ func getDefaultConfig() *ServerConfig {
 	if maxCollectorConnections := os.Getenv("MAX_COLLECTOR_CONNECTION"); maxCollectorConnections != "" {
 		config.MaxCollectorConnections, _ = strconv.Atoi(maxCollectorConnections)
 	}
+	if enableBloatExact := os.Getenv("PGA_ENABLE_BLOAT_EXACT"); enableBloatExact != "" && enableBloatExact != "0" {
+		config.EnableBloatExact = true
+	}
+	if bloatExactMaxSizeMb := os.Getenv("BLOAT_EXACT_MAX_SIZE_MB"); bloatExactMaxSizeMb != "" {
+		config.BloatExactMaxSizeMb, _ = strconv.Atoi(bloatExactMaxSizeMb)
+	}
+	if bloatExactTimeoutMs := os.Getenv("BLOAT_EXACT_TIMEOUT_MS"); bloatExactTimeoutMs != "" {
+		config.BloatExactTimeoutMs, _ = strconv.Atoi(bloatExactTimeoutMs)
+	}
+	if enableGenericExplain := os.Getenv("PGA_ENABLE_GENERIC_EXPLAIN"); enableGenericExplain != "" && enableGenericExplain != "0" {
+		config.EnableGenericExplain = true
+	}
+	if genericExplainTopN := os.Getenv("GENERIC_EXPLAIN_TOP_N"); genericExplainTopN != "" {
+		config.GenericExplainTopN, _ = strconv.Atoi(genericExplainTopN)
+	}
+	if localReports := os.Getenv("LOCAL_REPORTS"); localReports != "" {
+		config.LocalReports = localReports
+	}
+	if localReportsDirectory := os.Getenv("LOCAL_REPORTS_DIRECTORY"); localReportsDirectory != "" {
+		config.LocalReportsDirectory = localReportsDirectory
+	}
+	if localReportsFormat := os.Getenv("LOCAL_REPORTS_FORMAT"); localReportsFormat != "" {
+		config.LocalReportsFormat = localReportsFormat
+	}
 	if filterLogSecret := os.Getenv("FILTER_LOG_SECRET"); filterLogSecret != "" {
 		config.FilterLogSecret = filterLogSecret
 	}
@@ -344,6 +377,14 @@ func preprocessConfig(config *ServerConfig) (*ServerConfig, error) {
 		config.DbSslKey, err = writeValueToTempfile(config.DbSslKeyContents)
 	}
 
+	if config.DbPasswordFile != "" && config.DbPassword == "" {
+		password, err := ioutil.ReadFile(config.DbPasswordFile)
+		if err != nil {
+			return config, err
+		}
+		config.DbPassword = strings.TrimRight(string(password), "\r\n")
+	}
+
 	if config.AwsEndpointSigningRegionLegacy != "" && config.AwsEndpointSigningRegion == "" {
 		config.AwsEndpointSigningRegion = config.AwsEndpointSigningRegionLegacy
 	}
@@ -356,8 +397,10 @@ func Read(logger *util.Logger, filename string) (Config, error) {
 	var conf Config
 	var err error
 
+	conf.MaxConcurrency = defaultMaxConcurrency
+
 	if _, err = os.Stat(filename); err == nil {
-		configFile, err := ini.Load(filename)
+		configFile, err := loadConfigFile(filename)
 		if err != nil {
 			return conf, err
 		}
@@ -368,6 +411,12 @@ func Read(logger *util.Logger, filename string) (Config, error) {
 		if err != nil {
 			logger.PrintVerbose("Failed to map pganalyze section: %s", err)
 		}
+		conf.StatusListenAddress = configFile.Section("pganalyze").Key("status_listen_address").String()
+		conf.MaxConcurrency = configFile.Section("pganalyze").Key("max_concurrency").MustInt(defaultMaxConcurrency)
+		conf.MaxConcurrentFullSnapshots = configFile.Section("pganalyze").Key("max_concurrent_full_snapshots").MustInt(0)
+		conf.MaxConcurrentActivitySnapshots = configFile.Section("pganalyze").Key("max_concurrent_activity_snapshots").MustInt(0)
+		conf.MaxConcurrentQueryStatsSnapshots = configFile.Section("pganalyze").Key("max_concurrent_query_stats_snapshots").MustInt(0)
+		conf.MemoryBudgetMB = configFile.Section("pganalyze").Key("memory_budget_mb").MustInt(0)
 
 		sections := configFile.Sections()
 		for _, section := range sections {
@@ -379,6 +428,14 @@ func Read(logger *util.Logger, filename string) (Config, error) {
 				return conf, err
 			}
 
+			// Discovery sections are only preprocessed once the settings for each
+			// discovered server are known
+			if IsDiscoverySection(section.Name()) {
+				config.SectionName = section.Name()
+				conf.Discovery = append(conf.Discovery, *config)
+				continue
+			}
+
 			config, err = preprocessConfig(config)
 			if err != nil {
 				return conf, err
@@ -410,7 +467,7 @@ func Read(logger *util.Logger, filename string) (Config, error) {
 			}
 		}
 
-		if len(conf.Servers) == 0 {
+		if len(conf.Servers) == 0 && len(conf.Discovery) == 0 {
 			return conf, fmt.Errorf("Configuration file is empty, please edit %s and reload the collector", filename)
 		}
 	} else {
@@ -443,6 +500,16 @@ func Read(logger *util.Logger, filename string) (Config, error) {
 		}
 	}
 
+	if statusListenAddress := os.Getenv("PGA_STATUS_LISTEN_ADDRESS"); statusListenAddress != "" {
+		conf.StatusListenAddress = statusListenAddress
+	}
+	if maxConcurrency, err := strconv.Atoi(os.Getenv("PGA_MAX_CONCURRENCY")); err == nil {
+		conf.MaxConcurrency = maxConcurrency
+	}
+	if memoryBudgetMB, err := strconv.Atoi(os.Getenv("PGA_MEMORY_BUDGET_MB")); err == nil {
+		conf.MemoryBudgetMB = memoryBudgetMB
+	}
+
 	var hasIgnoreTablePattern = false
 	for _, server := range conf.Servers {
 		if server.IgnoreTablePattern != "" {
@@ -0,0 +1,380 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-ini/ini"
+)
+
+// ValidationProblem - Mistake in the configuration file found by Validate
+type ValidationProblem struct {
+	Section string
+	Key     string
+	Message string
+	Warning bool // Warnings (e.g. deprecated settings) don't cause the validation to fail
+}
+
+func (p ValidationProblem) String() string {
+	level := "ERROR"
+	if p.Warning {
+		level = "WARNING"
+	}
+	if p.Key != "" {
+		return fmt.Sprintf("%s [%s] %s: %s", level, p.Section, p.Key, p.Message)
+	}
+	return fmt.Sprintf("%s [%s] %s", level, p.Section, p.Message)
+}
+
+// Settings that are only valid in the [pganalyze] section, in addition to all server settings
+var globalOnlyKeys = append([]string{"status_listen_address", "include_dir"}, globalOnlyIntKeys...)
+
+// Global settings that take a non-negative integer
+var globalOnlyIntKeys = []string{"max_concurrency", "max_concurrent_full_snapshots", "max_concurrent_activity_snapshots", "max_concurrent_query_stats_snapshots", "memory_budget_mb"}
+
+var supportedFilterLogSecret = []string{"none", "all", "credential", "parsing_error", "statement_text", "statement_parameter", "table_data", "ops", "unidentified"}
+var supportedFilterQuerySample = []string{"none", "all"}
+var supportedFilterQueryText = []string{"none", "unparsable", "normalize"}
+var supportedSslModes = []string{"disable", "allow", "prefer", "require", "verify-ca", "verify-full"}
+var supportedLocalReportsFormats = []string{"csv", "table", "json"}
+var supportedDbHAFollow = []string{"primary", "replica"}
+var supportedDiscoverKubernetesKinds = []string{"service", "pod", "cloudnativepg", "zalando", "crunchydata"}
+
+// Validate - Checks the configuration file for mistakes that Read accepts silently, or that
+// would only be discovered at runtime
+func Validate(filename string) ([]ValidationProblem, error) {
+	var problems []ValidationProblem
+
+	configFile, err := loadConfigFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	knownKeys := serverConfigKeys()
+
+	defaultConfig := getDefaultConfig()
+	configFile.Section("pganalyze").MapTo(defaultConfig)
+
+	databaseSections := make(map[string]string)
+	hasServers := false
+
+	for _, section := range configFile.Sections() {
+		if section.Name() == ini.DEFAULT_SECTION && len(section.Keys()) == 0 {
+			continue
+		}
+
+		for _, key := range section.Keys() {
+			problems = append(problems, validateKey(section.Name(), key, knownKeys)...)
+		}
+
+		config := &ServerConfig{}
+		*config = *defaultConfig
+		err = section.MapTo(config)
+		if err != nil {
+			problems = append(problems, ValidationProblem{Section: section.Name(), Message: err.Error()})
+			continue
+		}
+
+		if IsDiscoverySection(section.Name()) {
+			hasServers = true
+			if IsRdsDiscoverySection(section.Name()) && config.AwsRegion == "" {
+				problems = append(problems, ValidationProblem{Section: section.Name(), Key: "aws_region", Message: "is required for discovering Amazon RDS instances"})
+			}
+			problems = append(problems, validateServerConfig(section.Name(), *config)...)
+			continue
+		}
+
+		if config.DbURL != "" {
+			if _, err := url.Parse(config.DbURL); err != nil {
+				problems = append(problems, ValidationProblem{Section: section.Name(), Key: "db_url", Message: err.Error()})
+				continue
+			}
+		}
+
+		dbName := strings.TrimSpace(strings.Split(config.GetDbName(), ",")[0])
+		if dbName == "" {
+			if section.Name() != "pganalyze" && section.Name() != ini.DEFAULT_SECTION {
+				problems = append(problems, ValidationProblem{Section: section.Name(), Message: "neither db_name nor db_url is set, this section will be ignored"})
+			}
+			continue
+		}
+		hasServers = true
+
+		problems = append(problems, validateServerConfig(section.Name(), *config)...)
+		if config.HAEnabled() {
+			continue
+		}
+
+		port := config.GetDbPort()
+		if port == 0 {
+			port = 5432
+		}
+		database := fmt.Sprintf("%s:%d/%s", config.GetDbHost(), port, dbName)
+		if otherSection, exists := databaseSections[database]; exists {
+			problems = append(problems, ValidationProblem{Section: section.Name(), Message: fmt.Sprintf("points at the same database as [%s] (%s), only one of them will be monitored", otherSection, database)})
+		} else {
+			databaseSections[database] = section.Name()
+		}
+	}
+
+	if !hasServers {
+		problems = append(problems, ValidationProblem{Section: "pganalyze", Message: "no servers configured (at least one section needs db_name or db_url)"})
+	}
+
+	return problems, nil
+}
+
+// serverConfigKeys - Returns the type of each setting supported in a server section, by its name
+func serverConfigKeys() map[string]reflect.Kind {
+	keys := make(map[string]reflect.Kind)
+	t := reflect.TypeOf(ServerConfig{})
+	for i := 0; i < t.NumField(); i++ {
+		name := t.Field(i).Tag.Get("ini")
+		if name != "" && name != "-" {
+			keys[name] = t.Field(i).Type.Kind()
+		}
+	}
+	return keys
+}
+
+func validateKey(sectionName string, key *ini.Key, knownKeys map[string]reflect.Kind) (problems []ValidationProblem) {
+	kind, known := knownKeys[key.Name()]
+	if !known {
+		for _, globalKey := range globalOnlyKeys {
+			if key.Name() == globalKey {
+				if sectionName != "pganalyze" {
+					problems = append(problems, ValidationProblem{Section: sectionName, Key: key.Name(), Message: "this setting is only supported in the [pganalyze] section"})
+				} else if contains(globalOnlyIntKeys, key.Name()) {
+					if value, err := key.Int(); err != nil || value < 0 {
+						problems = append(problems, ValidationProblem{Section: sectionName, Key: key.Name(), Message: fmt.Sprintf("\"%s\" is not a non-negative integer", key.String())})
+					}
+				}
+				return
+			}
+		}
+
+		message := "unknown setting"
+		if suggestion := closestKey(key.Name(), knownKeys); suggestion != "" {
+			message += fmt.Sprintf(", did you mean \"%s\"?", suggestion)
+		}
+		problems = append(problems, ValidationProblem{Section: sectionName, Key: key.Name(), Message: message})
+		return
+	}
+
+	switch kind {
+	case reflect.Bool:
+		if _, err := key.Bool(); err != nil {
+			problems = append(problems, ValidationProblem{Section: sectionName, Key: key.Name(), Message: fmt.Sprintf("\"%s\" is not a boolean (use true/false, on/off or 1/0)", key.String())})
+		}
+	case reflect.Int:
+		if _, err := key.Int(); err != nil {
+			problems = append(problems, ValidationProblem{Section: sectionName, Key: key.Name(), Message: fmt.Sprintf("\"%s\" is not an integer", key.String())})
+		}
+	}
+	return
+}
+
+// closestKey - Suggests the known setting that is most similar to the given unknown one
+func closestKey(name string, knownKeys map[string]reflect.Kind) string {
+	var candidates []string
+	for key := range knownKeys {
+		candidates = append(candidates, key)
+	}
+	candidates = append(candidates, globalOnlyKeys...)
+	sort.Strings(candidates)
+
+	// Only suggest settings that are reasonably close, to avoid confusing suggestions
+	best := ""
+	bestDistance := len(name)/3 + 2
+	for _, candidate := range candidates {
+		distance := levenshteinDistance(name, candidate)
+		if distance < bestDistance {
+			best = candidate
+			bestDistance = distance
+		}
+	}
+	return best
+}
+
+func levenshteinDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = minInt(minInt(previous[j]+1, current[j-1]+1), previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func validateServerConfig(sectionName string, config ServerConfig) (problems []ValidationProblem) {
+	problem := func(key string, format string, args ...interface{}) {
+		problems = append(problems, ValidationProblem{Section: sectionName, Key: key, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if config.APIKey == "" {
+		problem("api_key", "is required")
+	}
+	if config.QueryStatsInterval != 60 && config.QueryStatsInterval != 600 {
+		problem("query_stats_interval", "%d is not supported (supported: 60, 600)", config.QueryStatsInterval)
+	}
+	if config.DbPort != 0 && (config.DbPort < 1 || config.DbPort > 65535) {
+		problem("db_port", "%d is not a valid port", config.DbPort)
+	}
+	if config.DbSslMode != "" && !contains(supportedSslModes, config.DbSslMode) {
+		problem("db_sslmode", "\"%s\" is not supported (supported: %s)", config.DbSslMode, strings.Join(supportedSslModes, ", "))
+	}
+	if config.MaxCollectorConnections < 1 {
+		problem("max_collector_connections", "needs to be at least 1")
+	}
+	if config.EnableBloatExact && (config.BloatExactMaxSizeMb < 1 || config.BloatExactTimeoutMs < 1) {
+		problem("enable_bloat_exact", "bloat_exact_max_size_mb and bloat_exact_timeout_ms need to be positive")
+	}
+	if config.EnableGenericExplain && config.GenericExplainTopN < 1 {
+		problem("generic_explain_top_n", "needs to be at least 1")
+	}
+
+	if config.IgnoreSchemaRegexp != "" {
+		if _, err := regexp.Compile(config.IgnoreSchemaRegexp); err != nil {
+			problem("ignore_schema_regexp", "invalid regular expression: %s", err)
+		}
+	}
+	if config.IgnoreTablePattern != "" {
+		if _, err := filepath.Match(config.IgnoreTablePattern, ""); err != nil {
+			problem("ignore_table_pattern", "invalid pattern: %s", err)
+		}
+		problems = append(problems, ValidationProblem{Section: sectionName, Key: "ignore_table_pattern", Message: "deprecated, please use ignore_schema_regexp instead", Warning: true})
+	}
+
+	if config.FilterLogSecret != "" {
+		for _, kind := range strings.Split(config.FilterLogSecret, ",") {
+			if !contains(supportedFilterLogSecret, strings.TrimSpace(kind)) {
+				problem("filter_log_secret", "\"%s\" is not supported (supported: %s)", strings.TrimSpace(kind), strings.Join(supportedFilterLogSecret, ", "))
+			}
+		}
+	}
+	if config.FilterQuerySample != "" && !contains(supportedFilterQuerySample, config.FilterQuerySample) {
+		problem("filter_query_sample", "\"%s\" is not supported (supported: %s)", config.FilterQuerySample, strings.Join(supportedFilterQuerySample, ", "))
+	}
+	if config.FilterQueryText != "" && !contains(supportedFilterQueryText, config.FilterQueryText) {
+		problem("filter_query_text", "\"%s\" is not supported (supported: %s)", config.FilterQueryText, strings.Join(supportedFilterQueryText, ", "))
+	}
+
+	if config.LocalReports != "" && config.LocalReportsDirectory == "" {
+		problem("local_reports", "requires local_reports_directory to be set")
+	}
+	if !contains(supportedLocalReportsFormats, config.LocalReportsFormat) {
+		problem("local_reports_format", "\"%s\" is not supported (supported: %s)", config.LocalReportsFormat, strings.Join(supportedLocalReportsFormats, ", "))
+	}
+	var haSources []string
+	for _, source := range []struct{ key, value string }{{"db_ha_members", config.DbHAMembers}, {"db_ha_patroni_url", config.DbHAPatroniURL}, {"db_ha_consul_url", config.DbHAConsulURL}, {"db_ha_etcd_url", config.DbHAEtcdURL}} {
+		if source.value != "" {
+			haSources = append(haSources, source.key)
+		}
+	}
+	if len(haSources) > 1 {
+		problem(haSources[0], "can't be combined with %s", strings.Join(haSources[1:], ", "))
+	}
+	if (config.DbHAConsulURL != "" || config.DbHAEtcdURL != "") && config.DbHAPatroniScope == "" {
+		problem("db_ha_patroni_scope", "needs to be set when using db_ha_consul_url or db_ha_etcd_url")
+	}
+	if config.DbHAFollow != "" && !contains(supportedDbHAFollow, config.DbHAFollow) {
+		problem("db_ha_follow", "\"%s\" is not supported (supported: %s)", config.DbHAFollow, strings.Join(supportedDbHAFollow, ", "))
+	}
+	if config.HAEnabled() && config.DbHost != "" {
+		problems = append(problems, ValidationProblem{Section: sectionName, Key: "db_host", Message: "is ignored, since the cluster members are determined using " + strings.Join(haSources, ", "), Warning: true})
+	}
+	if config.HAEnabled() && config.DbURL != "" {
+		problems = append(problems, ValidationProblem{Section: sectionName, Key: "db_url", Message: "host and port are ignored, since the cluster members are determined using " + strings.Join(haSources, ", "), Warning: true})
+	}
+	if config.WebhookURL != "" {
+		if webhookURL, err := url.Parse(config.WebhookURL); err != nil || (webhookURL.Scheme != "http" && webhookURL.Scheme != "https") || webhookURL.Host == "" {
+			problem("webhook_url", "\"%s\" is not a valid http(s) URL", config.WebhookURL)
+		}
+		if _, err := config.GetWebhookHeaders(); err != nil {
+			problem("webhook_headers", "%s", err)
+		}
+		if config.WebhookRetries < 0 {
+			problem("webhook_retries", "can't be negative")
+		}
+	} else if config.WebhookHeaders != "" || config.WebhookSecret != "" || config.WebhookStateChangesOnly {
+		problems = append(problems, ValidationProblem{Section: sectionName, Key: "webhook_url", Message: "is not set, the other webhook_* settings are ignored", Warning: true})
+	}
+	if config.DiscoverKubernetesKind != "" && !contains(supportedDiscoverKubernetesKinds, config.DiscoverKubernetesKind) {
+		problem("discover_kubernetes_kind", "\"%s\" is not supported (supported: %s)", config.DiscoverKubernetesKind, strings.Join(supportedDiscoverKubernetesKinds, ", "))
+	}
+
+	// Log sources
+	var logSources []string
+	if config.LogLocation != "" {
+		logSources = append(logSources, "db_log_location")
+	}
+	if config.LogDockerTail != "" {
+		logSources = append(logSources, "db_log_docker_tail")
+	}
+	if config.AwsDbInstanceID != "" {
+		logSources = append(logSources, "aws_db_instance_id")
+	}
+	if config.AzureEventhubName != "" || config.AzureEventhubNamespace != "" {
+		logSources = append(logSources, "azure_eventhub_name")
+	}
+	if config.GcpPubsubSubscription != "" {
+		logSources = append(logSources, "gcp_pubsub_subscription")
+	}
+	if len(logSources) > 1 && !config.DisableLogs {
+		problem("", "conflicting log sources configured (%s), only one of them is used", strings.Join(logSources, ", "))
+	}
+
+	if (config.AzureEventhubName == "") != (config.AzureEventhubNamespace == "") {
+		problem("azure_eventhub_name", "azure_eventhub_name and azure_eventhub_namespace need to be set together")
+	}
+	if config.AzureEventhubName != "" {
+		if config.AzureDbServerName == "" && !strings.HasSuffix(config.GetDbHost(), ".postgres.database.azure.com") {
+			problem("azure_db_server_name", "is required when using azure_eventhub_name (or set db_host to the .postgres.database.azure.com hostname)")
+		}
+		if config.AzureADTenantID == "" || config.AzureADClientID == "" {
+			problem("azure_eventhub_name", "requires azure_ad_tenant_id and azure_ad_client_id to be set")
+		}
+		if config.AzureADClientSecret == "" && config.AzureADCertificatePath == "" {
+			problem("azure_eventhub_name", "requires either azure_ad_client_secret or azure_ad_certificate_path to be set")
+		}
+	}
+	if config.GcpPubsubSubscription != "" && config.GcpCloudSQLInstanceID == "" {
+		problem("gcp_pubsub_subscription", "requires gcp_cloudsql_instance_id to be set")
+	}
+	if (config.AwsAccessKeyID == "") != (config.AwsSecretAccessKey == "") {
+		problem("aws_access_key_id", "aws_access_key_id and aws_secret_access_key need to be set together")
+	}
+
+	return
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
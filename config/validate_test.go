@@ -0,0 +1,142 @@
+package config_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pganalyze/collector/config"
+)
+
+var validateTests = []struct {
+	input    string
+	expected []string
+}{
+	{
+		`[pganalyze]
+api_key = abc
+
+[server1]
+db_host = localhost
+db_name = app
+`,
+		nil,
+	},
+	{
+		`[pganalyze]
+api_key = abc
+
+[server1]
+db_host = localhost
+db_name = app
+db_usrname = x
+query_stats_interval = 30
+enable_reports = maybe
+ignore_schema_regexp = ^foo(
+filter_log_secret = credential,passwords
+
+[server2]
+db_url = postgres://u@localhost:5432/app
+gcp_pubsub_subscription = sub
+`,
+		[]string{
+			`ERROR [server1] db_usrname: unknown setting, did you mean "db_username"?`,
+			`ERROR [server1] enable_reports: "maybe" is not a boolean (use true/false, on/off or 1/0)`,
+			`ERROR [server1] query_stats_interval: 30 is not supported (supported: 60, 600)`,
+			"ERROR [server1] ignore_schema_regexp: invalid regular expression: error parsing regexp: missing closing ): `^foo(`",
+			`ERROR [server1] filter_log_secret: "passwords" is not supported (supported: none, all, credential, parsing_error, statement_text, statement_parameter, table_data, ops, unidentified)`,
+			`ERROR [server2] gcp_pubsub_subscription: requires gcp_cloudsql_instance_id to be set`,
+			`ERROR [server2] points at the same database as [server1] (localhost:5432/app), only one of them will be monitored`,
+		},
+	},
+	{
+		`[pganalyze]
+api_key = abc
+max_concurrency = 10
+memory_budget_mb = -1
+
+[server1]
+db_host = localhost
+db_name = app
+max_concurrent_full_snapshots = 2
+`,
+		[]string{
+			`ERROR [pganalyze] memory_budget_mb: "-1" is not a non-negative integer`,
+			`ERROR [server1] max_concurrent_full_snapshots: this setting is only supported in the [pganalyze] section`,
+		},
+	},
+	{
+		`[pganalyze]
+api_key = abc
+
+[server1]
+db_host = localhost
+db_name = app
+webhook_url = hooks.example.com
+webhook_headers = Authorization Bearer abc
+
+[server2]
+db_host = localhost
+db_name = other
+webhook_state_changes_only = true
+`,
+		[]string{
+			`ERROR [server1] webhook_url: "hooks.example.com" is not a valid http(s) URL`,
+			`ERROR [server1] webhook_headers: invalid header "Authorization Bearer abc", expected "Name: Value"`,
+			`WARNING [server2] webhook_url: is not set, the other webhook_* settings are ignored`,
+		},
+	},
+	{
+		`[pganalyze]
+api_key = abc
+
+[cluster]
+db_ha_patroni_url = http://pg1:8008
+db_ha_consul_url = http://localhost:8500
+db_name = app
+`,
+		[]string{
+			`ERROR [cluster] db_ha_patroni_url: can't be combined with db_ha_consul_url`,
+			`ERROR [cluster] db_ha_patroni_scope: needs to be set when using db_ha_consul_url or db_ha_etcd_url`,
+		},
+	},
+	{
+		`[pganalyze]
+api_key = abc
+
+[cluster]
+db_url = postgres://pganalyze@pg1:5432/app
+db_ha_members = pg1, pg2
+`,
+		[]string{
+			`WARNING [cluster] db_url: host and port are ignored, since the cluster members are determined using db_ha_members`,
+		},
+	},
+}
+
+func TestValidate(t *testing.T) {
+	for _, test := range validateTests {
+		file, err := ioutil.TempFile("", "pganalyze_collector_test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		file.WriteString(test.input)
+		file.Close()
+
+		problems, err := config.Validate(file.Name())
+		os.Remove(file.Name())
+		if err != nil {
+			t.Errorf("Validate(%q): unexpected error %s", test.input, err)
+			continue
+		}
+
+		var actual []string
+		for _, problem := range problems {
+			actual = append(actual, problem.String())
+		}
+		if diff := pretty.Compare(test.expected, actual); diff != "" {
+			t.Errorf("Validate(%q): diff: (-want +got)\n%s", test.input, diff)
+		}
+	}
+}
@@ -38,6 +38,7 @@ require (
 	github.com/smartystreets/goconvey v0.0.0-20160704134950-4622128e06c7 // indirect
 	golang.org/x/net v0.0.0-20200927032502-5d4f70055728
 	google.golang.org/api v0.32.0
+	gopkg.in/yaml.v2 v2.2.2
 )
 
 go 1.14
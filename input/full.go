@@ -67,11 +67,17 @@ func CollectFull(server *state.Server, connection *sql.DB, globalCollectionOpts
 	}
 
 	if globalCollectionOpts.CollectPostgresSettings {
-		ts.Settings, err = postgres.GetSettings(connection, ts.Version)
+		ps.Settings, err = postgres.GetSettings(connection, ts.Version)
 		if err != nil {
 			logger.PrintError("Error collecting config settings")
 			return
 		}
+
+		ts.SettingFileErrors, err = postgres.GetSettingFileErrors(connection, ts.Version)
+		if err != nil {
+			logger.PrintVerbose("Skipping configuration file errors, due to error: %s", err)
+			err = nil
+		}
 	}
 
 	ts.Replication, err = postgres.GetReplication(logger, connection, ts.Version, systemType)
@@ -82,12 +88,22 @@ func CollectFull(server *state.Server, connection *sql.DB, globalCollectionOpts
 		err = nil
 	}
 
+	ps.XidSample, err = postgres.GetXidSample(connection, ts.Version)
+	if err != nil {
+		logger.PrintWarning("Skipping transaction ID counter sample, due to error: %s", err)
+		err = nil
+	}
+
 	ts.BackendCounts, err = postgres.GetBackendCounts(logger, connection, ts.Version, server.Config.SystemType)
 	if err != nil {
 		logger.PrintError("Error collecting backend counts: %s", err)
 		return
 	}
 
+	if server.Config.EnableSecurityInfo {
+		ts.Security = postgres.CollectServerSecurityInfo(logger, connection, ts.Version)
+	}
+
 	ps, ts = postgres.CollectAllSchemas(server, globalCollectionOpts, logger, ps, ts, systemType)
 
 	if server.Config.IgnoreTablePattern != "" {
@@ -108,6 +124,10 @@ func CollectFull(server *state.Server, connection *sql.DB, globalCollectionOpts
 		ps.Relations = filteredRelations
 	}
 
+	if server.Config.EnableSecurityInfo {
+		logSecurityInfo(ts, globalCollectionOpts, logger)
+	}
+
 	if globalCollectionOpts.CollectSystemInformation {
 		ps.System = system.GetSystemState(server.Config, logger)
 	}
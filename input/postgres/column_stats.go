@@ -0,0 +1,159 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+const columnStatsSQL string = `
+SELECT schemaname, tablename, attname, inherited, null_frac, avg_width, n_distinct, correlation
+	FROM %s
+ WHERE schemaname NOT IN ('pg_catalog','pg_toast','information_schema')
+			 AND ($1 = '' OR (schemaname || '.' || tablename) !~* $1)`
+
+const extendedStatsSQLpg10 string = `
+SELECT e.stxrelid,
+			 n.nspname,
+			 e.stxname,
+			 pg_catalog.pg_get_statisticsobjdef(e.oid),
+			 e.stxkind::text,
+			 e.stxndistinct::text,
+			 e.stxdependencies::text
+	FROM pg_catalog.pg_statistic_ext e
+	JOIN pg_catalog.pg_namespace n ON (n.oid = e.stxnamespace)
+	JOIN pg_catalog.pg_class c ON (c.oid = e.stxrelid)
+	JOIN pg_catalog.pg_namespace cn ON (cn.oid = c.relnamespace)
+ WHERE ($1 = '' OR (cn.nspname || '.' || c.relname) !~* $1)`
+
+// Starting with Postgres 12 the computed data is only visible through
+// pg_stats_ext, which applies the same privilege checks as pg_stats
+const extendedStatsSQLpg12 string = `
+SELECT DISTINCT ON (e.oid)
+			 e.stxrelid,
+			 n.nspname,
+			 e.stxname,
+			 pg_catalog.pg_get_statisticsobjdef(e.oid),
+			 e.stxkind::text,
+			 s.n_distinct::text,
+			 s.dependencies::text
+	FROM pg_catalog.pg_statistic_ext e
+	JOIN pg_catalog.pg_namespace n ON (n.oid = e.stxnamespace)
+	JOIN pg_catalog.pg_class c ON (c.oid = e.stxrelid)
+	JOIN pg_catalog.pg_namespace cn ON (cn.oid = c.relnamespace)
+	LEFT JOIN %s s ON (s.statistics_schemaname = n.nspname AND s.statistics_name = e.stxname)
+ WHERE ($1 = '' OR (cn.nspname || '.' || c.relname) !~* $1)
+ ORDER BY e.oid, s.n_distinct IS NULL AND s.dependencies IS NULL`
+
+type columnStatsKey struct {
+	schemaName   string
+	relationName string
+	columnName   string
+}
+
+// GetColumnStats - Collects the planner statistics summary for all table columns and
+// attaches it to the given relations, as well as extended statistics (Postgres 10+)
+func GetColumnStats(logger *util.Logger, db *sql.DB, postgresVersion state.PostgresVersion, relations []state.PostgresRelation, ignoreRegexp string) error {
+	var sourceTable string
+
+	if statsHelperExists(db, "get_column_stats") {
+		logger.PrintVerbose("Found pganalyze.get_column_stats() stats helper")
+		sourceTable = "pganalyze.get_column_stats()"
+	} else {
+		sourceTable = "pg_catalog.pg_stats"
+	}
+
+	rows, err := db.Query(QueryMarkerSQL+fmt.Sprintf(columnStatsSQL, sourceTable), ignoreRegexp)
+	if err != nil {
+		return fmt.Errorf("ColumnStats/Query: %s", err)
+	}
+	defer rows.Close()
+
+	columnStats := make(map[columnStatsKey][]state.PostgresColumnStats)
+
+	for rows.Next() {
+		var key columnStatsKey
+		var stats state.PostgresColumnStats
+		var nullFrac, nDistinct null.Float
+		var avgWidth null.Int
+
+		err = rows.Scan(&key.schemaName, &key.relationName, &key.columnName, &stats.Inherited,
+			&nullFrac, &avgWidth, &nDistinct, &stats.Correlation)
+		if err != nil {
+			return fmt.Errorf("ColumnStats/Scan: %s", err)
+		}
+
+		stats.NullFrac = nullFrac.Float64
+		stats.AvgWidth = int32(avgWidth.Int64)
+		stats.NDistinct = nDistinct.Float64
+
+		columnStats[key] = append(columnStats[key], stats)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return fmt.Errorf("ColumnStats/Rows: %s", err)
+	}
+
+	relationIdxByOid := make(map[state.Oid]int)
+	for relIdx, relation := range relations {
+		relationIdxByOid[relation.Oid] = relIdx
+		for colIdx, column := range relation.Columns {
+			key := columnStatsKey{relation.SchemaName, relation.RelationName, column.Name}
+			relations[relIdx].Columns[colIdx].Stats = columnStats[key]
+		}
+	}
+
+	if postgresVersion.Numeric < state.PostgresVersion10 {
+		return nil
+	}
+
+	var extendedStatsSQL string
+	if postgresVersion.Numeric >= state.PostgresVersion12 {
+		var extSourceTable string
+		if statsHelperExists(db, "get_extended_stats") {
+			logger.PrintVerbose("Found pganalyze.get_extended_stats() stats helper")
+			extSourceTable = "pganalyze.get_extended_stats()"
+		} else {
+			extSourceTable = "pg_catalog.pg_stats_ext"
+		}
+		extendedStatsSQL = fmt.Sprintf(extendedStatsSQLpg12, extSourceTable)
+	} else {
+		extendedStatsSQL = extendedStatsSQLpg10
+	}
+
+	rows, err = db.Query(QueryMarkerSQL+extendedStatsSQL, ignoreRegexp)
+	if err != nil {
+		return fmt.Errorf("ExtendedStats/Query: %s", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row state.PostgresExtendedStatistic
+		var kinds null.String
+
+		err = rows.Scan(&row.RelationOid, &row.SchemaName, &row.Name, &row.Definition,
+			&kinds, &row.NDistinct, &row.Dependencies)
+		if err != nil {
+			return fmt.Errorf("ExtendedStats/Scan: %s", err)
+		}
+
+		row.Kinds = unpackPostgresStringArray(kinds)
+
+		relIdx, ok := relationIdxByOid[row.RelationOid]
+		if !ok {
+			continue
+		}
+		relations[relIdx].ExtendedStats = append(relations[relIdx].ExtendedStats, row)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return fmt.Errorf("ExtendedStats/Rows: %s", err)
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package postgres
 import (
 	"database/sql"
 
+	"github.com/guregu/null"
 	"github.com/pganalyze/collector/state"
 )
 
@@ -17,3 +18,9 @@ func CurrentDatabaseName(db *sql.DB) (result string, err error) {
 	err = db.QueryRow(QueryMarkerSQL + "SELECT pg_catalog.current_database()").Scan(&result)
 	return
 }
+
+// CurrentDatabaseStatsResetAt - Get the time statistics of the current database were last reset (null if never)
+func CurrentDatabaseStatsResetAt(db *sql.DB) (result null.Time, err error) {
+	err = db.QueryRow(QueryMarkerSQL + "SELECT stats_reset FROM pg_catalog.pg_stat_database WHERE datname = pg_catalog.current_database()").Scan(&result)
+	return
+}
@@ -11,11 +11,11 @@ import (
 )
 
 func EstablishConnection(server *state.Server, logger *util.Logger, globalCollectionOpts state.CollectionOpts, databaseName string) (connection *sql.DB, err error) {
-	connection, err = connectToDb(server.Config, logger, globalCollectionOpts, databaseName)
+	connection, err = connectToServer(server, logger, globalCollectionOpts, databaseName)
 	if err != nil {
-		if err.Error() == "pq: SSL is not enabled on the server" && (server.Config.DbSslMode == "prefer" || server.Config.DbSslMode == "") {
+		if isSslNotEnabledError(err) && (server.Config.DbSslMode == "prefer" || server.Config.DbSslMode == "") {
 			server.Config.DbSslModePreferFailed = true
-			connection, err = connectToDb(server.Config, logger, globalCollectionOpts, databaseName)
+			connection, err = connectToServer(server, logger, globalCollectionOpts, databaseName)
 		}
 	}
 
@@ -34,6 +34,17 @@ func EstablishConnection(server *state.Server, logger *util.Logger, globalCollec
 	return
 }
 
+func isSslNotEnabledError(err error) bool {
+	return err.Error() == "pq: SSL is not enabled on the server"
+}
+
+func connectToServer(server *state.Server, logger *util.Logger, globalCollectionOpts state.CollectionOpts, databaseName string) (*sql.DB, error) {
+	if server.Config.HAEnabled() {
+		return connectToHAMember(server, logger, globalCollectionOpts, databaseName)
+	}
+	return connectToDb(server.Config, logger, globalCollectionOpts, databaseName)
+}
+
 func connectToDb(config config.ServerConfig, logger *util.Logger, globalCollectionOpts state.CollectionOpts, databaseName string) (*sql.DB, error) {
 	connectString := config.GetPqOpenString(databaseName)
 	connectString += " application_name=" + globalCollectionOpts.CollectorApplicationName
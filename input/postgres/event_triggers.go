@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/state"
+)
+
+const eventTriggersSQL string = `
+SELECT e.evtname,
+			 e.evtevent,
+			 pg_catalog.pg_get_userbyid(e.evtowner),
+			 n.nspname,
+			 p.proname,
+			 e.evtenabled,
+			 e.evttags
+	FROM pg_catalog.pg_event_trigger e
+	JOIN pg_catalog.pg_proc p ON (p.oid = e.evtfoid)
+	JOIN pg_catalog.pg_namespace n ON (n.oid = p.pronamespace)`
+
+func GetEventTriggers(db *sql.DB, postgresVersion state.PostgresVersion, currentDatabaseOid state.Oid) ([]state.PostgresEventTrigger, error) {
+	if postgresVersion.Numeric < state.PostgresVersion93 {
+		return nil, nil
+	}
+
+	stmt, err := db.Prepare(QueryMarkerSQL + eventTriggersSQL)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var eventTriggers []state.PostgresEventTrigger
+
+	for rows.Next() {
+		var row state.PostgresEventTrigger
+		var tags null.String
+
+		err := rows.Scan(&row.Name, &row.Event, &row.Owner, &row.FunctionSchema,
+			&row.FunctionName, &row.Enabled, &tags)
+		if err != nil {
+			return nil, err
+		}
+
+		row.DatabaseOid = currentDatabaseOid
+		row.Tags = unpackPostgresStringArray(tags)
+
+		eventTriggers = append(eventTriggers, row)
+	}
+
+	return eventTriggers, rows.Err()
+}
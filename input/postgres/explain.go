@@ -72,15 +72,12 @@ func RunExplain(server *state.Server, inputs []state.PostgresQuerySample, collec
 
 func runDbExplain(db *sql.DB, inputs []state.PostgresQuerySample, useHelper bool) (outputs []state.PostgresQuerySample) {
 	for _, sample := range inputs {
-		// To be on the safe side never EXPLAIN a statement that can't be parsed,
-		// or multiple statements in one (leading to accidental execution)
-		parsetree, err := pg_query.Parse(sample.Query)
-		if err != nil || len(parsetree.Statements) != 1 {
+		explainable, parsed := isExplainable(sample.Query)
+		if !parsed {
 			continue
 		}
-		stmt := parsetree.Statements[0].(pg_query_nodes.RawStmt).Stmt
-		switch stmt.(type) {
-		case pg_query_nodes.SelectStmt, pg_query_nodes.InsertStmt, pg_query_nodes.UpdateStmt, pg_query_nodes.DeleteStmt:
+		if explainable {
+			var err error
 			sample.HasExplain = true
 			sample.ExplainSource = pganalyze_collector.QuerySample_STATEMENT_LOG_EXPLAIN_SOURCE
 			sample.ExplainFormat = pganalyze_collector.QuerySample_JSON_EXPLAIN_FORMAT
@@ -120,6 +117,24 @@ func runDbExplain(db *sql.DB, inputs []state.PostgresQuerySample, useHelper bool
 	return
 }
 
+// isExplainable - Returns whether the query is a single SELECT, INSERT, UPDATE or DELETE
+// statement, and whether it could be parsed at all
+//
+// To be on the safe side never EXPLAIN a statement that can't be parsed,
+// or multiple statements in one (leading to accidental execution)
+func isExplainable(query string) (explainable bool, parsed bool) {
+	parsetree, err := pg_query.Parse(query)
+	if err != nil || len(parsetree.Statements) != 1 {
+		return false, false
+	}
+	stmt := parsetree.Statements[0].(pg_query_nodes.RawStmt).Stmt
+	switch stmt.(type) {
+	case pg_query_nodes.SelectStmt, pg_query_nodes.InsertStmt, pg_query_nodes.UpdateStmt, pg_query_nodes.DeleteStmt:
+		return true, true
+	}
+	return false, true
+}
+
 func contains(strs []string, val string) bool {
 	for _, str := range strs {
 		if str == val {
@@ -0,0 +1,188 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+var queryParamRegexp = regexp.MustCompile(`\$(\d+)`)
+
+// RunGenericExplain - EXPLAINs the statements with the highest total time as generic
+// plans (i.e. without knowing the parameter values), and returns them as query samples
+//
+// On Postgres 16+ this uses EXPLAIN (GENERIC_PLAN), on older versions the statement is
+// prepared and executed with plan_cache_mode = force_generic_plan (Postgres 12+), or
+// executed repeatedly until the plan cache switches to a generic plan.
+func RunGenericExplain(server *state.Server, ps state.PersistedState, ts state.TransientState, collectionOpts state.CollectionOpts, logger *util.Logger) (samples []state.PostgresQuerySample) {
+	databaseNames := make(map[state.Oid]string)
+	for _, database := range ts.Databases {
+		databaseNames[database.Oid] = database.Name
+	}
+	roleNames := make(map[state.Oid]string)
+	for _, role := range ts.Roles {
+		roleNames[role.Oid] = role.Name
+	}
+
+	var keys []state.PostgresStatementKey
+	for key, statement := range ts.Statements {
+		if statement.Collector || statement.Unidentified || statement.InsufficientPrivilege {
+			continue
+		}
+		if _, ok := ps.StatementStats[key]; !ok {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return ps.StatementStats[keys[i]].TotalTime > ps.StatementStats[keys[j]].TotalTime
+	})
+
+	var samplesByDb = make(map[string]([]state.PostgresQuerySample))
+	seenFingerprints := make(map[string]map[[21]byte]bool)
+	selected := 0
+	for _, key := range keys {
+		if selected >= server.Config.GenericExplainTopN {
+			break
+		}
+
+		dbName, ok := databaseNames[key.DatabaseOid]
+		monitoredDb := ok && (dbName == server.Config.GetDbName() || server.Config.DbAllNames || contains(server.Config.DbExtraNames, dbName))
+		if !monitoredDb {
+			continue
+		}
+
+		// The same query text may be tracked separately for multiple users
+		fingerprint := ts.Statements[key].Fingerprint
+		if seenFingerprints[dbName] == nil {
+			seenFingerprints[dbName] = make(map[[21]byte]bool)
+		}
+		if seenFingerprints[dbName][fingerprint] {
+			continue
+		}
+		seenFingerprints[dbName][fingerprint] = true
+
+		query := ts.StatementTexts[fingerprint]
+		if explainable, _ := isExplainable(query); !explainable {
+			continue
+		}
+
+		stats := ps.StatementStats[key]
+		sample := state.PostgresQuerySample{
+			Username: roleNames[key.UserOid],
+			Database: dbName,
+			Query:    query,
+		}
+		if stats.Calls > 0 {
+			sample.RuntimeMs = stats.TotalTime / float64(stats.Calls)
+		}
+		samplesByDb[dbName] = append(samplesByDb[dbName], sample)
+		selected++
+	}
+
+	for dbName, dbSamples := range samplesByDb {
+		db, err := EstablishConnection(server, logger, collectionOpts, dbName)
+		if err != nil {
+			logger.PrintVerbose("Could not connect to %s to run generic explain: %s; skipping", dbName, err)
+			continue
+		}
+
+		samples = append(samples, runDbGenericExplain(db, dbSamples, ts.Version)...)
+		db.Close()
+	}
+
+	return
+}
+
+func runDbGenericExplain(db *sql.DB, inputs []state.PostgresQuerySample, version state.PostgresVersion) (outputs []state.PostgresQuerySample) {
+	for _, sample := range inputs {
+		var err error
+		sample.OccurredAt = time.Now()
+		sample.HasExplain = true
+		sample.ExplainSource = pganalyze_collector.QuerySample_GENERIC_EXPLAIN_SOURCE
+		sample.ExplainFormat = pganalyze_collector.QuerySample_JSON_EXPLAIN_FORMAT
+
+		if version.Numeric >= state.PostgresVersion16 {
+			err = db.QueryRow(QueryMarkerSQL + "EXPLAIN (GENERIC_PLAN, VERBOSE, FORMAT JSON) " + sample.Query).Scan(&sample.ExplainOutput)
+		} else {
+			err = explainPreparedGenericPlan(db, sample.Query, version, &sample.ExplainOutput)
+		}
+		if err != nil {
+			sample.ExplainError = fmt.Sprintf("%s", err)
+		}
+
+		outputs = append(outputs, sample)
+	}
+
+	return
+}
+
+// Before Postgres 12 the plan cache considers a generic plan once a prepared statement
+// was planned this many times with custom plans
+const genericPlanCustomPlanCount = 5
+
+// explainPreparedGenericPlan - Prepares the query with unknown-typed parameters, and
+// EXPLAINs its execution with NULL values while forcing a generic plan
+//
+// Before Postgres 12 a generic plan can't be forced. Instead the statement is EXPLAINed
+// with NULL values until the plan cache stops using custom plans, which only happens if
+// the generic plan isn't estimated to be more expensive than the custom plans. Since
+// NULL values often make custom plans trivially cheap, this fails for some queries.
+func explainPreparedGenericPlan(db *sql.DB, query string, version state.PostgresVersion, explainOutput *string) error {
+	paramCount := 0
+	for _, match := range queryParamRegexp.FindAllStringSubmatch(query, -1) {
+		n, _ := strconv.Atoi(match[1])
+		if n > paramCount {
+			paramCount = n
+		}
+	}
+
+	_, err := db.Exec(QueryMarkerSQL + "PREPARE pganalyze_explain AS " + query)
+	if err != nil {
+		return err
+	}
+	defer db.Exec(QueryMarkerSQL + "DEALLOCATE pganalyze_explain")
+
+	executeStmt := "EXECUTE pganalyze_explain"
+	if paramCount > 0 {
+		executeStmt += "(" + strings.TrimSuffix(strings.Repeat("NULL, ", paramCount), ", ") + ")"
+	}
+
+	if version.Numeric >= state.PostgresVersion12 {
+		_, err = db.Exec(QueryMarkerSQL + "SET plan_cache_mode = force_generic_plan")
+		if err != nil {
+			return err
+		}
+		defer db.Exec(QueryMarkerSQL + "RESET plan_cache_mode")
+
+		return db.QueryRow(QueryMarkerSQL + "EXPLAIN (VERBOSE, FORMAT JSON) " + executeStmt).Scan(explainOutput)
+	}
+
+	// EXPLAIN EXECUTE goes through the plan cache like a regular execution, without running the query
+	for i := 0; i <= genericPlanCustomPlanCount; i++ {
+		err = db.QueryRow(QueryMarkerSQL + "EXPLAIN (VERBOSE, FORMAT JSON) " + executeStmt).Scan(explainOutput)
+		if err != nil {
+			return err
+		}
+		if paramCount == 0 {
+			// Without parameters the custom plan is the same as the generic plan
+			break
+		}
+	}
+
+	// Custom plans have the NULL values substituted, generic plans reference the parameters
+	if paramCount > 0 && !queryParamRegexp.MatchString(*explainOutput) {
+		*explainOutput = ""
+		return fmt.Errorf("could not get generic plan: Postgres kept using custom plans (plan_cache_mode requires Postgres 12 or newer)")
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/state"
+)
+
+const extensionsSQL string = `
+SELECT e.extname,
+			 e.extversion,
+			 n.nspname,
+			 ae.default_version,
+			 (SELECT pg_catalog.array_agg(aev.version)
+					FROM pg_catalog.pg_available_extension_versions aev
+				 WHERE aev.name = e.extname) AS available_versions
+	FROM pg_catalog.pg_extension e
+	JOIN pg_catalog.pg_namespace n ON (n.oid = e.extnamespace)
+	LEFT JOIN pg_catalog.pg_available_extensions ae ON (ae.name = e.extname)`
+
+func GetExtensions(db *sql.DB, currentDatabaseOid state.Oid) ([]state.PostgresExtension, error) {
+	stmt, err := db.Prepare(QueryMarkerSQL + extensionsSQL)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var extensions []state.PostgresExtension
+
+	for rows.Next() {
+		var row state.PostgresExtension
+		var availableVersions null.String
+
+		err := rows.Scan(&row.ExtensionName, &row.Version, &row.SchemaName,
+			&row.DefaultVersion, &availableVersions)
+		if err != nil {
+			return nil, err
+		}
+
+		row.DatabaseOid = currentDatabaseOid
+		row.AvailableVersions = unpackPostgresStringArray(availableVersions)
+
+		extensions = append(extensions, row)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return extensions, nil
+}
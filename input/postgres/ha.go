@@ -0,0 +1,236 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+const isInRecoverySQL string = "SELECT pg_catalog.pg_is_in_recovery()"
+
+const systemIdentifierSQL string = "SELECT system_identifier::text FROM pg_catalog.pg_control_system()"
+
+// connectToHAMember - Connects to the cluster member the collector follows
+//
+// The connection goes to the member used before, as long as it still has the expected
+// role. Otherwise (e.g. after a failover) the roles of all members are checked again.
+func connectToHAMember(server *state.Server, logger *util.Logger, globalCollectionOpts state.CollectionOpts, databaseName string) (*sql.DB, error) {
+	role := haFollowRole(server.Config)
+
+	target, checked := server.GetHATarget()
+	if checked && target.Host != "" {
+		connection, err := connectToDb(haMemberConfig(server.Config, target), logger, globalCollectionOpts, databaseName)
+		if err == nil {
+			if memberRole(connection) == role {
+				return connection, nil
+			}
+			connection.Close()
+		} else if isSslNotEnabledError(err) {
+			// Let EstablishConnection retry without SSL when using sslmode=prefer
+			return nil, err
+		}
+	}
+
+	members, err := ResolveHAMembers(server.Config, logger, globalCollectionOpts)
+	if err != nil {
+		return nil, err
+	}
+	target, err = selectHATarget(members, role, target)
+	if err != nil {
+		return nil, err
+	}
+
+	changed, previous := server.SetHAMembers(members, target, role)
+	if changed {
+		logger.PrintInfo("HA: Following %s %s (previously %s)", role, target.Address(), previous.Address())
+	} else if !checked {
+		logger.PrintVerbose("HA: Connecting to %s %s", role, target.Address())
+	}
+
+	return connectToDb(haMemberConfig(server.Config, target), logger, globalCollectionOpts, databaseName)
+}
+
+// ResolveHAMembers - Determines the members of the cluster and their current roles, either
+// through the Patroni REST API, the Consul or etcd store Patroni uses, or by connecting to
+// each member in db_ha_members
+func ResolveHAMembers(config config.ServerConfig, logger *util.Logger, globalCollectionOpts state.CollectionOpts) ([]state.HAMember, error) {
+	if config.DbHAPatroniURL != "" {
+		return getPatroniMembers(config.DbHAPatroniURL)
+	}
+	if config.DbHAConsulURL != "" || config.DbHAEtcdURL != "" {
+		return getPatroniDCSMembers(config)
+	}
+
+	var members []state.HAMember
+	for _, address := range strings.Split(config.DbHAMembers, ",") {
+		member, err := parseHAMember(strings.TrimSpace(address), config.GetDbPort())
+		if err != nil {
+			return nil, err
+		}
+
+		connection, err := connectToDb(haMemberConfig(config, member), logger, globalCollectionOpts, "")
+		if err != nil && isSslNotEnabledError(err) && (config.DbSslMode == "prefer" || config.DbSslMode == "") {
+			config.DbSslModePreferFailed = true
+			connection, err = connectToDb(haMemberConfig(config, member), logger, globalCollectionOpts, "")
+		}
+		if err != nil {
+			logger.PrintVerbose("HA: Could not connect to %s: %s", member.Address(), err)
+			member.Role = state.HARoleUnreachable
+		} else {
+			member.Role = memberRole(connection)
+			connection.Close()
+		}
+		members = append(members, member)
+	}
+
+	return members, nil
+}
+
+// GetSystemIdentifier - Returns the database system identifier, which is the same on the
+// primary and its physical replicas (empty if it can't be determined, e.g. on Postgres 9.5)
+func GetSystemIdentifier(connection *sql.DB) string {
+	var systemIdentifier string
+	err := connection.QueryRow(QueryMarkerSQL + systemIdentifierSQL).Scan(&systemIdentifier)
+	if err != nil {
+		return ""
+	}
+	return systemIdentifier
+}
+
+func memberRole(connection *sql.DB) string {
+	var inRecovery bool
+	err := connection.QueryRow(QueryMarkerSQL + isInRecoverySQL).Scan(&inRecovery)
+	if err != nil {
+		return state.HARoleUnreachable
+	} else if inRecovery {
+		return state.HARoleReplica
+	}
+	return state.HARolePrimary
+}
+
+func haFollowRole(config config.ServerConfig) string {
+	if config.DbHAFollow == state.HARoleReplica {
+		return state.HARoleReplica
+	}
+	return state.HARolePrimary
+}
+
+func haMemberConfig(config config.ServerConfig, member state.HAMember) config.ServerConfig {
+	return config.WithHost(member.Host, member.Port)
+}
+
+func parseHAMember(address string, defaultPort int) (state.HAMember, error) {
+	if defaultPort == 0 {
+		defaultPort = 5432
+	}
+	if !strings.Contains(address, ":") || strings.HasSuffix(address, "]") {
+		return state.HAMember{Host: strings.Trim(address, "[]"), Port: defaultPort}, nil
+	}
+	host, portStr, err := net.SplitHostPort(address)
+	if err != nil {
+		return state.HAMember{}, fmt.Errorf("invalid db_ha_members entry \"%s\": %s", address, err)
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return state.HAMember{}, fmt.Errorf("invalid db_ha_members entry \"%s\": port needs to be a number", address)
+	}
+	return state.HAMember{Host: host, Port: port}, nil
+}
+
+// selectHATarget - Picks the member to connect to: the primary, or for replicas the
+// previous target if it is still a replica, otherwise the first replica
+func selectHATarget(members []state.HAMember, role string, previous state.HAMember) (state.HAMember, error) {
+	var candidates []state.HAMember
+	for _, member := range members {
+		if member.Role == role {
+			candidates = append(candidates, member)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return state.HAMember{}, fmt.Errorf("HA: no %s found among cluster members (%s)", role, describeHAMembers(members))
+	}
+	if role == state.HARolePrimary && len(candidates) > 1 {
+		return state.HAMember{}, fmt.Errorf("HA: more than one primary found among cluster members (%s)", describeHAMembers(members))
+	}
+	for _, candidate := range candidates {
+		if candidate.Address() == previous.Address() {
+			return candidate, nil
+		}
+	}
+	return candidates[0], nil
+}
+
+func describeHAMembers(members []state.HAMember) string {
+	var descriptions []string
+	for _, member := range members {
+		descriptions = append(descriptions, member.Address()+" "+member.Role)
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+type patroniCluster struct {
+	Members []struct {
+		Name  string `json:"name"`
+		Role  string `json:"role"`
+		State string `json:"state"`
+		Host  string `json:"host"`
+		Port  int    `json:"port"`
+	} `json:"members"`
+}
+
+// getPatroniMembers - Reads the cluster members from the first Patroni REST API that responds
+func getPatroniMembers(patroniURLs string) ([]state.HAMember, error) {
+	client := &http.Client{Timeout: 10 * time.Second}
+
+	var lastErr error
+	for _, patroniURL := range strings.Split(patroniURLs, ",") {
+		patroniURL = strings.TrimSuffix(strings.TrimSpace(patroniURL), "/")
+
+		var cluster patroniCluster
+		lastErr = getPatroniCluster(client, patroniURL+"/cluster", &cluster)
+		if lastErr != nil {
+			lastErr = fmt.Errorf("%s: %s", patroniURL, lastErr)
+			continue
+		}
+
+		var members []state.HAMember
+		for _, m := range cluster.Members {
+			member := state.HAMember{Name: m.Name, Host: m.Host, Port: m.Port}
+			switch {
+			case m.State != "running" && m.State != "streaming":
+				member.Role = state.HARoleUnreachable
+			case m.Role == "leader" || m.Role == "master":
+				member.Role = state.HARolePrimary
+			default:
+				member.Role = state.HARoleReplica
+			}
+			members = append(members, member)
+		}
+		return members, nil
+	}
+
+	return nil, fmt.Errorf("HA: could not get cluster members from Patroni: %s", lastErr)
+}
+
+func getPatroniCluster(client *http.Client, url string, cluster *patroniCluster) error {
+	resp, err := client.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(cluster)
+}
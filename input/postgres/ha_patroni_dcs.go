@@ -0,0 +1,202 @@
+package postgres
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/state"
+)
+
+// Patroni stores the cluster in its distributed configuration store (DCS) under
+// "<namespace>/<scope>/", with one key per member in "members/", and the name of the
+// current primary in "leader". Member keys expire when Patroni stops running on a member.
+
+// patroniDCSMember - Value of a member key, e.g.
+// {"conn_url": "postgres://10.0.0.1:5432/postgres", "role": "replica", "state": "streaming"}
+type patroniDCSMember struct {
+	ConnURL string `json:"conn_url"`
+	Role    string `json:"role"`
+	State   string `json:"state"`
+}
+
+// patroniDCSKey - Key and value read from Consul or etcd, whose APIs return values (and
+// for etcd also keys) base64 encoded, which encoding/json decodes into []byte
+type patroniDCSKey struct {
+	Key   []byte
+	Value []byte
+}
+
+type consulKey struct {
+	Key   string `json:"Key"`
+	Value []byte `json:"Value"`
+}
+
+type etcdRangeResponse struct {
+	Kvs []struct {
+		Key   []byte `json:"key"`
+		Value []byte `json:"value"`
+	} `json:"kvs"`
+}
+
+func patroniDCSPrefix(config config.ServerConfig) string {
+	namespace := config.DbHAPatroniNamespace
+	if namespace == "" {
+		namespace = "/service"
+	}
+	return "/" + strings.Trim(namespace, "/") + "/" + config.DbHAPatroniScope + "/"
+}
+
+// getPatroniDCSMembers - Reads the cluster members from the first Consul agent or etcd
+// endpoint that responds
+func getPatroniDCSMembers(config config.ServerConfig) ([]state.HAMember, error) {
+	client := &http.Client{Timeout: 10 * time.Second}
+	prefix := patroniDCSPrefix(config)
+
+	name := "Consul"
+	endpoints := config.DbHAConsulURL
+	getKeys := getConsulKeys
+	if config.DbHAEtcdURL != "" {
+		name = "etcd"
+		endpoints = config.DbHAEtcdURL
+		getKeys = getEtcdKeys
+	}
+
+	var lastErr error
+	for _, endpoint := range strings.Split(endpoints, ",") {
+		endpoint = strings.TrimSuffix(strings.TrimSpace(endpoint), "/")
+
+		var keys []patroniDCSKey
+		keys, lastErr = getKeys(client, endpoint, prefix)
+		if lastErr != nil {
+			lastErr = fmt.Errorf("%s: %s", endpoint, lastErr)
+			continue
+		}
+		members, err := patroniDCSMembers(keys, prefix)
+		if err != nil {
+			return nil, fmt.Errorf("HA: could not get cluster members from %s: %s", name, err)
+		}
+		return members, nil
+	}
+
+	return nil, fmt.Errorf("HA: could not get cluster members from %s: %s", name, lastErr)
+}
+
+// patroniDCSMembers - Converts the keys of the cluster to its members, sorted by name
+func patroniDCSMembers(keys []patroniDCSKey, prefix string) ([]state.HAMember, error) {
+	leader := ""
+	memberValues := make(map[string][]byte)
+	for _, key := range keys {
+		// Consul keys don't start with a slash, etcd keys do
+		path := strings.TrimPrefix("/"+strings.TrimPrefix(string(key.Key), "/"), prefix)
+		if path == "leader" {
+			leader = string(key.Value)
+		} else if name := strings.TrimPrefix(path, "members/"); name != path && name != "" && !strings.Contains(name, "/") {
+			memberValues[name] = key.Value
+		}
+	}
+
+	var members []state.HAMember
+	for name, value := range memberValues {
+		var m patroniDCSMember
+		err := json.Unmarshal(value, &m)
+		if err != nil {
+			return nil, fmt.Errorf("invalid member data for %s: %s", name, err)
+		}
+		connURL, err := url.Parse(m.ConnURL)
+		if err != nil || connURL.Hostname() == "" {
+			return nil, fmt.Errorf("invalid conn_url for %s: \"%s\"", name, m.ConnURL)
+		}
+		port := 5432
+		if connURL.Port() != "" {
+			port, _ = strconv.Atoi(connURL.Port())
+		}
+
+		member := state.HAMember{Name: name, Host: connURL.Hostname(), Port: port}
+		switch {
+		case m.State != "running" && m.State != "streaming":
+			member.Role = state.HARoleUnreachable
+		case leader != "" && name == leader:
+			member.Role = state.HARolePrimary
+		case leader == "" && (m.Role == "master" || m.Role == "primary"):
+			member.Role = state.HARolePrimary
+		default:
+			member.Role = state.HARoleReplica
+		}
+		members = append(members, member)
+	}
+	if len(members) == 0 {
+		return nil, fmt.Errorf("no members found in %s (check db_ha_patroni_scope and db_ha_patroni_namespace)", prefix)
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Name < members[j].Name
+	})
+	return members, nil
+}
+
+// getConsulKeys - Reads all keys with the prefix from the Consul KV store (Consul keys
+// don't start with a slash)
+func getConsulKeys(client *http.Client, consulURL string, prefix string) ([]patroniDCSKey, error) {
+	resp, err := client.Get(consulURL + "/v1/kv/" + strings.TrimPrefix(prefix, "/") + "?recurse=true")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	} else if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	var consulKeys []consulKey
+	err = json.NewDecoder(resp.Body).Decode(&consulKeys)
+	if err != nil {
+		return nil, err
+	}
+	var keys []patroniDCSKey
+	for _, k := range consulKeys {
+		keys = append(keys, patroniDCSKey{Key: []byte(k.Key), Value: k.Value})
+	}
+	return keys, nil
+}
+
+// getEtcdKeys - Reads all keys with the prefix using the JSON gateway of the etcd v3 API
+func getEtcdKeys(client *http.Client, etcdURL string, prefix string) ([]patroniDCSKey, error) {
+	// The range end is the prefix with its last byte incremented, i.e. all keys with the prefix
+	rangeEnd := []byte(prefix)
+	rangeEnd[len(rangeEnd)-1]++
+	body, err := json.Marshal(map[string][]byte{"key": []byte(prefix), "range_end": rangeEnd})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Post(etcdURL+"/v3/kv/range", "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	var rangeResponse etcdRangeResponse
+	err = json.NewDecoder(resp.Body).Decode(&rangeResponse)
+	if err != nil {
+		return nil, err
+	}
+	var keys []patroniDCSKey
+	for _, kv := range rangeResponse.Kvs {
+		keys = append(keys, patroniDCSKey{Key: kv.Key, Value: kv.Value})
+	}
+	return keys, nil
+}
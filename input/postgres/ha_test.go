@@ -0,0 +1,137 @@
+package postgres_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/input/postgres"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+const patroniClusterResponse = `{"members": [
+	{"name": "pg1", "role": "replica", "state": "streaming", "api_url": "http://10.0.0.1:8008/patroni", "host": "10.0.0.1", "port": 5432, "timeline": 5, "lag": 0},
+	{"name": "pg2", "role": "leader", "state": "running", "api_url": "http://10.0.0.2:8008/patroni", "host": "10.0.0.2", "port": 5432, "timeline": 5},
+	{"name": "pg3", "role": "replica", "state": "stopped", "api_url": "http://10.0.0.3:8008/patroni", "host": "10.0.0.3", "port": 5433}
+], "scope": "demo"}`
+
+func TestResolveHAMembersPatroni(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cluster" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, patroniClusterResponse)
+	}))
+	defer server.Close()
+
+	// The first API does not respond, the members are read from the second one
+	conf := config.ServerConfig{DbHAPatroniURL: "http://127.0.0.1:1, " + server.URL + "/"}
+	logger := &util.Logger{Destination: log.New(ioutil.Discard, "", 0)}
+
+	members, err := postgres.ResolveHAMembers(conf, logger, state.CollectionOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []state.HAMember{
+		{Name: "pg1", Host: "10.0.0.1", Port: 5432, Role: state.HARoleReplica},
+		{Name: "pg2", Host: "10.0.0.2", Port: 5432, Role: state.HARolePrimary},
+		{Name: "pg3", Host: "10.0.0.3", Port: 5433, Role: state.HARoleUnreachable},
+	}
+	if diff := pretty.Compare(expected, members); diff != "" {
+		t.Errorf("Unexpected cluster members, diff: (-want +got)\n%s", diff)
+	}
+}
+
+// Keys Patroni stores in Consul and etcd, for the cluster "demo" in the default namespace
+var patroniDCSKeys = map[string]string{
+	"service/demo/leader":       "pg2",
+	"service/demo/members/pg1":  `{"conn_url": "postgres://10.0.0.1:5432/postgres", "api_url": "http://10.0.0.1:8008/patroni", "state": "streaming", "role": "replica"}`,
+	"service/demo/members/pg2":  `{"conn_url": "postgres://10.0.0.2:5432/postgres", "api_url": "http://10.0.0.2:8008/patroni", "state": "running", "role": "primary"}`,
+	"service/demo/members/pg3":  `{"conn_url": "postgres://10.0.0.3:5433/postgres", "api_url": "http://10.0.0.3:8008/patroni", "state": "stopped", "role": "replica"}`,
+	"service/demo/config":       `{"ttl": 30}`,
+	"service/other/members/pg9": `{"conn_url": "postgres://10.0.0.9:5432/postgres", "state": "running", "role": "primary"}`,
+}
+
+var expectedPatroniDCSMembers = []state.HAMember{
+	{Name: "pg1", Host: "10.0.0.1", Port: 5432, Role: state.HARoleReplica},
+	{Name: "pg2", Host: "10.0.0.2", Port: 5432, Role: state.HARolePrimary},
+	{Name: "pg3", Host: "10.0.0.3", Port: 5433, Role: state.HARoleUnreachable},
+}
+
+func TestResolveHAMembersConsul(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/kv/service/demo/" || r.URL.Query().Get("recurse") == "" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var keys []map[string]interface{}
+		for key, value := range patroniDCSKeys {
+			if strings.HasPrefix(key, "service/demo/") {
+				keys = append(keys, map[string]interface{}{"Key": key, "Value": []byte(value)})
+			}
+		}
+		json.NewEncoder(w).Encode(keys)
+	}))
+	defer server.Close()
+
+	conf := config.ServerConfig{DbHAConsulURL: server.URL, DbHAPatroniScope: "demo"}
+	logger := &util.Logger{Destination: log.New(ioutil.Discard, "", 0)}
+
+	members, err := postgres.ResolveHAMembers(conf, logger, state.CollectionOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := pretty.Compare(expectedPatroniDCSMembers, members); diff != "" {
+		t.Errorf("Unexpected cluster members, diff: (-want +got)\n%s", diff)
+	}
+
+	conf.DbHAPatroniScope = "missing"
+	_, err = postgres.ResolveHAMembers(conf, logger, state.CollectionOpts{})
+	if err == nil || !strings.Contains(err.Error(), "no members found in /service/missing/") {
+		t.Errorf("Expected error for unknown scope, got %v", err)
+	}
+}
+
+func TestResolveHAMembersEtcd(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Key      []byte `json:"key"`
+			RangeEnd []byte `json:"range_end"`
+		}
+		if r.Method != "POST" || r.URL.Path != "/v3/kv/range" || json.NewDecoder(r.Body).Decode(&request) != nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var kvs []map[string][]byte
+		for key, value := range patroniDCSKeys {
+			etcdKey := "/" + key
+			if etcdKey >= string(request.Key) && etcdKey < string(request.RangeEnd) {
+				kvs = append(kvs, map[string][]byte{"key": []byte(etcdKey), "value": []byte(value)})
+			}
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"kvs": kvs})
+	}))
+	defer server.Close()
+
+	// The first endpoint does not respond, the members are read from the second one
+	conf := config.ServerConfig{DbHAEtcdURL: "http://127.0.0.1:1," + server.URL, DbHAPatroniScope: "demo", DbHAPatroniNamespace: "/service/"}
+	logger := &util.Logger{Destination: log.New(ioutil.Discard, "", 0)}
+
+	members, err := postgres.ResolveHAMembers(conf, logger, state.CollectionOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := pretty.Compare(expectedPatroniDCSMembers, members); diff != "" {
+		t.Errorf("Unexpected cluster members, diff: (-want +got)\n%s", diff)
+	}
+}
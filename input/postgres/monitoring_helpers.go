@@ -0,0 +1,348 @@
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// MonitoringHelper - Helper function in the pganalyze schema that gives the
+// monitoring user access to data that would otherwise require a superuser
+type MonitoringHelper struct {
+	Name          string
+	Arguments     string // Argument types, needed to identify the function before Postgres 10
+	MinVersion    int    // Minimum Postgres version the helper applies to
+	Extension     string // Extension the helper depends on, only created if the extension is installed
+	LogExplain    bool   // Only applies if enable_log_explain is set
+	MissingImpact string // What is degraded when the helper is missing
+
+	// Not needed when the monitoring user is a member of pg_monitor (Postgres 10+)
+	CoveredByMonitoringRole bool
+
+	// Returns the CREATE FUNCTION statement, given the schema of Extension (if any)
+	definition func(version state.PostgresVersion, extensionSchema string) string
+}
+
+// MonitoringHelpers - All helper functions the collector knows how to use
+var MonitoringHelpers = []MonitoringHelper{
+	{
+		Name:                    "get_stat_statements",
+		Arguments:               "boolean",
+		CoveredByMonitoringRole: true,
+		Extension:               "pg_stat_statements",
+		MissingImpact:           "query statistics only include query texts of the monitoring user's own queries",
+		definition: func(version state.PostgresVersion, extensionSchema string) string {
+			if version.Numeric < state.PostgresVersion94 {
+				return fmt.Sprintf(`CREATE OR REPLACE FUNCTION pganalyze.get_stat_statements(showtext boolean = true) RETURNS SETOF %[1]s.pg_stat_statements AS
+$$
+  /* pganalyze-collector */ SELECT * FROM %[1]s.pg_stat_statements();
+$$ LANGUAGE sql VOLATILE SECURITY DEFINER`, extensionSchema)
+			}
+			return fmt.Sprintf(`CREATE OR REPLACE FUNCTION pganalyze.get_stat_statements(showtext boolean = true) RETURNS SETOF %[1]s.pg_stat_statements AS
+$$
+  /* pganalyze-collector */ SELECT * FROM %[1]s.pg_stat_statements(showtext);
+$$ LANGUAGE sql VOLATILE SECURITY DEFINER`, extensionSchema)
+		},
+	},
+	{
+		Name:                    "get_stat_activity",
+		CoveredByMonitoringRole: true,
+		MissingImpact:           "query texts and states of other users' connections are hidden in activity snapshots",
+		definition: func(version state.PostgresVersion, extensionSchema string) string {
+			return `CREATE OR REPLACE FUNCTION pganalyze.get_stat_activity() RETURNS SETOF pg_stat_activity AS
+$$
+  /* pganalyze-collector */ SELECT * FROM pg_catalog.pg_stat_activity;
+$$ LANGUAGE sql VOLATILE SECURITY DEFINER`
+		},
+	},
+	{
+		Name:          "get_column_stats",
+		MissingImpact: "column statistics are only collected for tables the monitoring user can read",
+		definition: func(version state.PostgresVersion, extensionSchema string) string {
+			return `CREATE OR REPLACE FUNCTION pganalyze.get_column_stats() RETURNS SETOF pg_stats AS
+$$
+  /* pganalyze-collector */ SELECT schemaname, tablename, attname, inherited, null_frac, avg_width,
+  n_distinct, NULL::anyarray, most_common_freqs, NULL::anyarray, correlation, NULL::anyarray,
+  most_common_elem_freqs, elem_count_histogram
+  FROM pg_catalog.pg_stats;
+$$ LANGUAGE sql VOLATILE SECURITY DEFINER`
+		},
+	},
+	{
+		Name:                    "get_stat_replication",
+		CoveredByMonitoringRole: true,
+		MissingImpact:           "replication statistics of standbys are hidden",
+		definition: func(version state.PostgresVersion, extensionSchema string) string {
+			return `CREATE OR REPLACE FUNCTION pganalyze.get_stat_replication() RETURNS SETOF pg_stat_replication AS
+$$
+  /* pganalyze-collector */ SELECT * FROM pg_catalog.pg_stat_replication;
+$$ LANGUAGE sql VOLATILE SECURITY DEFINER`
+		},
+	},
+	{
+		Name:                    "get_stat_progress_vacuum",
+		CoveredByMonitoringRole: true,
+		MinVersion:              state.PostgresVersion96,
+		MissingImpact:           "VACUUM progress of other users' connections is hidden",
+		definition: func(version state.PostgresVersion, extensionSchema string) string {
+			return `CREATE OR REPLACE FUNCTION pganalyze.get_stat_progress_vacuum() RETURNS SETOF pg_stat_progress_vacuum AS
+$$
+  /* pganalyze-collector */ SELECT * FROM pg_catalog.pg_stat_progress_vacuum;
+$$ LANGUAGE sql VOLATILE SECURITY DEFINER`
+		},
+	},
+	{
+		Name:          "get_extended_stats",
+		MinVersion:    state.PostgresVersion12,
+		MissingImpact: "results of extended statistics (CREATE STATISTICS) are not collected",
+		definition: func(version state.PostgresVersion, extensionSchema string) string {
+			return `CREATE OR REPLACE FUNCTION pganalyze.get_extended_stats() RETURNS TABLE(
+  statistics_schemaname name, statistics_name name, n_distinct text, dependencies text
+) AS
+$$
+  /* pganalyze-collector */ SELECT statistics_schemaname, statistics_name, n_distinct::text, dependencies::text
+    FROM pg_catalog.pg_stats_ext;
+$$ LANGUAGE sql VOLATILE SECURITY DEFINER`
+		},
+	},
+	{
+		Name:                    "get_buffercache",
+		CoveredByMonitoringRole: true,
+		Extension:               "pg_buffercache",
+		MissingImpact:           "the Buffer Cache report is unavailable",
+		definition: func(version state.PostgresVersion, extensionSchema string) string {
+			return fmt.Sprintf(`CREATE OR REPLACE FUNCTION pganalyze.get_buffercache() RETURNS SETOF %[1]s.pg_buffercache AS
+$$
+  /* pganalyze-collector */ SELECT * FROM %[1]s.pg_buffercache;
+$$ LANGUAGE sql VOLATILE SECURITY DEFINER`, extensionSchema)
+		},
+	},
+	{
+		Name:          "get_sequence_oid_for_column",
+		Arguments:     "text, text",
+		MissingImpact: "the Sequence report is unavailable",
+		definition: func(version state.PostgresVersion, extensionSchema string) string {
+			return `CREATE OR REPLACE FUNCTION pganalyze.get_sequence_oid_for_column(table_name text, column_name text) RETURNS oid AS
+$$
+  /* pganalyze-collector */ SELECT pg_get_serial_sequence(table_name, column_name)::regclass::oid;
+$$ LANGUAGE sql VOLATILE SECURITY DEFINER`
+		},
+	},
+	{
+		Name:          "get_sequence_state",
+		Arguments:     "text, text",
+		MissingImpact: "the Sequence report is unavailable",
+		definition: func(version state.PostgresVersion, extensionSchema string) string {
+			if version.Numeric < state.PostgresVersion10 {
+				return `CREATE OR REPLACE FUNCTION pganalyze.get_sequence_state(schema_name text, sequence_name text) RETURNS TABLE(
+  last_value bigint, start_value bigint, increment_by bigint,
+  max_value bigint, min_value bigint, cache_size bigint, cycle boolean
+) AS
+$$
+BEGIN
+  IF NOT EXISTS(SELECT 1 FROM pg_class c JOIN pg_namespace n ON (c.relnamespace = n.oid) WHERE n.nspname = schema_name AND c.relname = sequence_name AND relkind = 'S') THEN
+    RETURN;
+  END IF;
+
+  RETURN QUERY EXECUTE 'SELECT last_value, start_value, increment_by, max_value, min_value, '
+     || 'cache_value AS cache_size, is_cycled AS cycle FROM '
+     || quote_ident(schema_name) || '.' || quote_ident(sequence_name);
+END
+$$ LANGUAGE plpgsql VOLATILE SECURITY DEFINER`
+			}
+			return `CREATE OR REPLACE FUNCTION pganalyze.get_sequence_state(schema_name text, sequence_name text) RETURNS TABLE(
+  last_value bigint, start_value bigint, increment_by bigint,
+  max_value bigint, min_value bigint, cache_size bigint, cycle boolean
+) AS
+$$
+  /* pganalyze-collector */ SELECT last_value, start_value, increment_by, max_value, min_value, cache_size, cycle
+    FROM pg_sequences WHERE schemaname = schema_name AND sequencename = sequence_name;
+$$ LANGUAGE sql VOLATILE SECURITY DEFINER`
+		},
+	},
+	{
+		Name:          "explain",
+		Arguments:     "text, text[]",
+		LogExplain:    true,
+		MissingImpact: "slow queries from the Postgres log are not automatically EXPLAINed",
+		definition: func(version state.PostgresVersion, extensionSchema string) string {
+			return `CREATE OR REPLACE FUNCTION pganalyze.explain(query text, params text[]) RETURNS text AS
+$$
+DECLARE
+  prepared_query text;
+  prepared_params text;
+  result text;
+BEGIN
+  SELECT regexp_replace(query, ';+\s*\Z', '') INTO prepared_query;
+  IF prepared_query LIKE '%;%' THEN
+    RAISE EXCEPTION 'cannot run EXPLAIN when query contains semicolon';
+  END IF;
+
+  IF array_length(params, 1) > 0 THEN
+    SELECT string_agg(quote_literal(param) || '::unknown', ',') FROM unnest(params) p(param) INTO prepared_params;
+
+    EXECUTE 'PREPARE pganalyze_explain AS ' || prepared_query;
+    BEGIN
+      EXECUTE 'EXPLAIN (VERBOSE, FORMAT JSON) EXECUTE pganalyze_explain(' || prepared_params || ')' INTO STRICT result;
+    EXCEPTION WHEN OTHERS THEN
+      DEALLOCATE pganalyze_explain;
+      RAISE;
+    END;
+    DEALLOCATE pganalyze_explain;
+  ELSE
+    EXECUTE 'EXPLAIN (VERBOSE, FORMAT JSON) ' || prepared_query INTO STRICT result;
+  END IF;
+
+  RETURN result;
+END
+$$ LANGUAGE plpgsql VOLATILE SECURITY DEFINER`
+		},
+	},
+}
+
+const extensionSchemaSQL string = `
+SELECT n.nspname
+	FROM pg_catalog.pg_extension e
+	JOIN pg_catalog.pg_namespace n ON (n.oid = e.extnamespace)
+ WHERE e.extname = $1`
+
+const roleExistsSQL string = `SELECT EXISTS(SELECT 1 FROM pg_catalog.pg_roles WHERE rolname = $1)`
+
+// MonitoringHelperStatus - Whether a helper function that applies to a database is installed
+type MonitoringHelperStatus struct {
+	Helper    MonitoringHelper
+	Installed bool
+}
+
+// applicableHelpers - Returns the helpers that apply to the Postgres version and
+// server configuration, together with the schema of the extension they depend on
+func applicableHelpers(db *sql.DB, server *state.Server, version state.PostgresVersion) (helpers []MonitoringHelper, extensionSchemas map[string]string, err error) {
+	extensionSchemas = make(map[string]string)
+	for _, helper := range MonitoringHelpers {
+		if version.Numeric < helper.MinVersion || (helper.LogExplain && !server.Config.EnableLogExplain) {
+			continue
+		}
+		if helper.Extension != "" {
+			var schema string
+			err = db.QueryRow(QueryMarkerSQL+extensionSchemaSQL, helper.Extension).Scan(&schema)
+			if err == sql.ErrNoRows {
+				err = nil
+				continue
+			} else if err != nil {
+				return
+			}
+			extensionSchemas[helper.Extension] = pq.QuoteIdentifier(schema)
+		}
+		helpers = append(helpers, helper)
+	}
+	return
+}
+
+// CheckMonitoringHelpers - Returns which of the applicable helper functions exist in the current database
+func CheckMonitoringHelpers(db *sql.DB, server *state.Server, version state.PostgresVersion) ([]MonitoringHelperStatus, error) {
+	helpers, _, err := applicableHelpers(db, server, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []MonitoringHelperStatus
+	for _, helper := range helpers {
+		statuses = append(statuses, MonitoringHelperStatus{Helper: helper, Installed: statsHelperExists(db, helper.Name)})
+	}
+
+	return statuses, nil
+}
+
+// MonitoringPrivileges - Returns whether the current connection is a superuser,
+// and whether it is a member of pg_monitor (Postgres 10+)
+func MonitoringPrivileges(db *sql.DB, systemType string) (superuser bool, monitoringRole bool) {
+	return connectedAsSuperUser(db, systemType), connectedAsMonitoringRole(db)
+}
+
+// SetupMonitoringRole - Creates the monitoring role (or updates its password), and
+// grants it pg_monitor on Postgres 10+
+//
+// Roles are shared between all databases, so this only needs to run once per server.
+func SetupMonitoringRole(db *sql.DB, logger *util.Logger, version state.PostgresVersion, roleName string, password string) error {
+	var exists bool
+	err := db.QueryRow(QueryMarkerSQL+roleExistsSQL, roleName).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("MonitoringRole/Query: %s", err)
+	}
+
+	role := pq.QuoteIdentifier(roleName)
+	if !exists {
+		_, err = db.Exec(QueryMarkerSQL + "CREATE ROLE " + role + " WITH LOGIN CONNECTION LIMIT 5")
+		if err != nil {
+			return fmt.Errorf("MonitoringRole/Create: %s", err)
+		}
+		logger.PrintInfo("Created role %s", roleName)
+	}
+
+	if password != "" {
+		_, err = db.Exec(QueryMarkerSQL + "ALTER ROLE " + role + " WITH LOGIN PASSWORD " + pq.QuoteLiteral(password))
+		if err != nil {
+			return fmt.Errorf("MonitoringRole/Password: %s", err)
+		}
+	}
+
+	if version.Numeric >= state.PostgresVersion10 {
+		_, err = db.Exec(QueryMarkerSQL + "GRANT pg_monitor TO " + role)
+		if err != nil {
+			return fmt.Errorf("MonitoringRole/Grant: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// SetupMonitoringHelpers - Creates (or replaces) the pganalyze schema and all applicable
+// helper functions in the current database, and allows the monitoring role to use them
+//
+// pg_stat_statements is only installed when installStatements is set, i.e. for the
+// database that query statistics are collected from.
+func SetupMonitoringHelpers(db *sql.DB, logger *util.Logger, server *state.Server, version state.PostgresVersion, roleName string, installStatements bool) error {
+	role := pq.QuoteIdentifier(roleName)
+	statements := []string{
+		"CREATE SCHEMA IF NOT EXISTS pganalyze",
+		"GRANT USAGE ON SCHEMA pganalyze TO " + role,
+	}
+	if installStatements {
+		statements = append(statements, "CREATE EXTENSION IF NOT EXISTS pg_stat_statements")
+	}
+	for _, statement := range statements {
+		_, err := db.Exec(QueryMarkerSQL + statement)
+		if err != nil {
+			return fmt.Errorf("MonitoringHelpers/Schema: %s", err)
+		}
+	}
+
+	helpers, extensionSchemas, err := applicableHelpers(db, server, version)
+	if err != nil {
+		return fmt.Errorf("MonitoringHelpers/Query: %s", err)
+	}
+
+	for _, helper := range helpers {
+		definition := helper.definition(version, extensionSchemas[helper.Extension])
+		_, err = db.Exec(QueryMarkerSQL + definition)
+
+		// CREATE OR REPLACE can't change the result type of an existing function
+		var e *pq.Error
+		if err != nil && errors.As(err, &e) && e.Code == "42P13" { // invalid_function_definition
+			logger.PrintVerbose("Recreating helper function pganalyze.%s, due to error: %s", helper.Name, err)
+			_, err = db.Exec(QueryMarkerSQL + "DROP FUNCTION pganalyze." + helper.Name + "(" + helper.Arguments + ")")
+			if err == nil {
+				_, err = db.Exec(QueryMarkerSQL + definition)
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("MonitoringHelpers/Create %s: %s", helper.Name, err)
+		}
+	}
+
+	return nil
+}
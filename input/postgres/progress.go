@@ -0,0 +1,153 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// Each part normalizes one of the pg_stat_progress_* views into a common set of
+// columns, so we can collect all of them in a single query
+
+const progressCreateIndexSQLpg12 string = `
+SELECT pid, relid, index_relid, 'CREATE INDEX' AS command, command AS command_detail, phase,
+			 blocks_total, blocks_done, tuples_total, tuples_done, 0 AS bytes_total, 0 AS bytes_done
+	FROM pg_catalog.pg_stat_progress_create_index`
+
+const progressClusterSQLpg12 string = `
+SELECT pid, relid, cluster_index_relid, 'CLUSTER', command, phase,
+			 heap_blks_total, heap_blks_scanned, 0, heap_tuples_scanned, 0, 0
+	FROM pg_catalog.pg_stat_progress_cluster`
+
+const progressAnalyzeSQLpg13 string = `
+SELECT pid, relid, 0::oid, 'ANALYZE', 'ANALYZE', phase,
+			 sample_blks_total, sample_blks_scanned, 0, 0, 0, 0
+	FROM pg_catalog.pg_stat_progress_analyze`
+
+const progressBaseBackupSQLpg13 string = `
+SELECT pid, 0::oid, 0::oid, 'BASE_BACKUP', 'BASE_BACKUP', phase,
+			 0, 0, 0, 0, COALESCE(backup_total, 0), backup_streamed
+	FROM pg_catalog.pg_stat_progress_basebackup`
+
+const progressCopySQLpg14 string = `
+SELECT pid, relid, 0::oid, 'COPY', command, '',
+			 0, 0, 0, tuples_processed, bytes_total, bytes_processed
+	FROM pg_catalog.pg_stat_progress_copy`
+
+const progressSQL string = `
+WITH progress AS (
+	%s
+),
+activity AS (
+	SELECT pid,
+				 pg_catalog.to_char(pid, 'FM0000000') AS padded_pid,
+				 EXTRACT(epoch FROM COALESCE(query_start, backend_start, pg_catalog.pg_postmaster_start_time()))::int::text AS query_start_epoch,
+				 EXTRACT(epoch FROM COALESCE(backend_start, pg_catalog.pg_postmaster_start_time()))::int::text AS backend_start_epoch,
+				 COALESCE(datname, '') AS datname,
+				 COALESCE(usename, '') AS usename,
+				 COALESCE(query_start, backend_start, pg_catalog.pg_postmaster_start_time()) AS started_at
+		FROM %s
+)
+SELECT (a.query_start_epoch || a.padded_pid)::bigint AS progress_identity,
+			 (a.backend_start_epoch || a.padded_pid)::bigint AS backend_identity,
+			 p.pid,
+			 p.command,
+			 COALESCE(p.command_detail, ''),
+			 a.datname,
+			 COALESCE(n.nspname, ''),
+			 CASE
+				 WHEN ($1 = '' OR (n.nspname || '.' || c.relname) !~* $1) THEN COALESCE(c.relname, '')
+				 ELSE ''
+			 END AS relname,
+			 COALESCE(ic.relname, ''),
+			 a.usename,
+			 a.started_at,
+			 COALESCE(p.phase, ''),
+			 COALESCE(p.blocks_total, 0)::bigint,
+			 COALESCE(p.blocks_done, 0)::bigint,
+			 COALESCE(p.tuples_total, 0)::bigint,
+			 COALESCE(p.tuples_done, 0)::bigint,
+			 COALESCE(p.bytes_total, 0)::bigint,
+			 COALESCE(p.bytes_done, 0)::bigint
+	FROM progress p
+			 JOIN activity a USING (pid)
+			 LEFT JOIN pg_catalog.pg_class c ON (c.oid = p.relid)
+			 LEFT JOIN pg_catalog.pg_namespace n ON (n.oid = c.relnamespace)
+			 LEFT JOIN pg_catalog.pg_class ic ON (ic.oid = p.index_relid)
+`
+
+// GetProgress - Collects progress information for all operations that report
+// it, except for VACUUM (see GetVacuumProgress)
+//
+// Note that relation and index names can only be resolved for operations
+// running in the database we're connected to.
+func GetProgress(logger *util.Logger, db *sql.DB, postgresVersion state.PostgresVersion, ignoreRegexp string) ([]state.PostgresProgress, error) {
+	var parts []string
+	var activitySourceTable string
+
+	if postgresVersion.Numeric >= state.PostgresVersion12 {
+		parts = append(parts, progressCreateIndexSQLpg12, progressClusterSQLpg12)
+	}
+	if postgresVersion.Numeric >= state.PostgresVersion13 {
+		parts = append(parts, progressAnalyzeSQLpg13, progressBaseBackupSQLpg13)
+	}
+	if postgresVersion.Numeric >= state.PostgresVersion14 {
+		parts = append(parts, progressCopySQLpg14)
+	}
+
+	if len(parts) == 0 {
+		return nil, nil
+	}
+
+	if statsHelperExists(db, "get_stat_activity") {
+		activitySourceTable = "pganalyze.get_stat_activity()"
+	} else {
+		activitySourceTable = "pg_catalog.pg_stat_activity"
+	}
+
+	sql := fmt.Sprintf(progressSQL, strings.Join(parts, "\n	UNION ALL"), activitySourceTable)
+
+	stmt, err := db.Prepare(QueryMarkerSQL + sql)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(ignoreRegexp)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var progress []state.PostgresProgress
+
+	for rows.Next() {
+		var row state.PostgresProgress
+
+		err := rows.Scan(&row.ProgressIdentity, &row.BackendIdentity, &row.Pid, &row.Command,
+			&row.CommandDetail, &row.DatabaseName, &row.SchemaName, &row.RelationName,
+			&row.IndexName, &row.RoleName, &row.StartedAt, &row.Phase, &row.BlocksTotal,
+			&row.BlocksDone, &row.TuplesTotal, &row.TuplesDone, &row.BytesTotal, &row.BytesDone)
+		if err != nil {
+			return nil, err
+		}
+
+		if row.RelationName == "" {
+			row.SchemaName = ""
+			row.IndexName = ""
+		}
+
+		progress = append(progress, row)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return progress, nil
+}
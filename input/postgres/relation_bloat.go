@@ -189,9 +189,7 @@ SELECT nspname,
 	JOIN pg_catalog.pg_class c ON (c.oid = iae.table_oid)
 `
 
-// TODO: Figure out how to introduce precise bloat queries here, e.g.
-// SELECT index_size, index_size * (1.0 - avg_leaf_density / 100.0) FROM pgstatindex('some_index_pkey'::regclass);
-// http://blog.ioguix.net/postgresql/2014/03/28/Playing-with-indexes-and-better-bloat-estimate.html
+// See relation_bloat_exact.go for precise measurements using pgstattuple
 
 func GetRelationBloat(logger *util.Logger, db *sql.DB, columnStatsSourceTable string, ignoreRegexp string) (relBloat []state.PostgresRelationBloat, err error) {
 	rows, err := db.Query(QueryMarkerSQL+fmt.Sprintf(tableBloatSQL, columnStatsSourceTable, columnStatsSourceTable), ignoreRegexp)
@@ -0,0 +1,160 @@
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/lib/pq"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+const pgstattupleSchemaSQL string = `
+SELECT n.nspname
+	FROM pg_catalog.pg_extension e
+	JOIN pg_catalog.pg_namespace n ON (n.oid = e.extnamespace)
+ WHERE e.extname = 'pgstattuple'
+			 AND EXISTS(SELECT 1 FROM pg_catalog.pg_proc p WHERE p.pronamespace = n.oid AND p.proname = 'pgstattuple_approx')`
+
+const exactBloatCandidatesSQL string = `
+SELECT c.oid,
+			 n.nspname,
+			 c.relname,
+			 c.relkind = 'i' AS is_index,
+			 COALESCE((SELECT option_value::int FROM pg_catalog.pg_options_to_table(c.reloptions) WHERE option_name = 'fillfactor'),
+								CASE WHEN c.relkind = 'i' THEN 90 ELSE 100 END)
+	FROM pg_catalog.pg_class c
+	JOIN pg_catalog.pg_namespace n ON (n.oid = c.relnamespace)
+	LEFT JOIN pg_catalog.pg_index i ON (i.indexrelid = c.oid)
+	LEFT JOIN pg_catalog.pg_class t ON (t.oid = i.indrelid)
+	LEFT JOIN pg_catalog.pg_am am ON (am.oid = c.relam)
+ WHERE (c.relkind IN ('r', 'm') OR (c.relkind = 'i' AND am.amname = 'btree'))
+			 AND c.relpersistence <> 't'
+			 AND n.nspname NOT IN ('pg_catalog', 'pg_toast', 'information_schema')
+			 AND pg_catalog.pg_relation_size(c.oid) BETWEEN 1 AND $2
+			 AND ($1 = '' OR (n.nspname || '.' || COALESCE(t.relname, c.relname)) !~* $1)`
+
+const tableBloatExactSQL string = `
+SELECT table_len, approx_tuple_len, approx_tuple_count, dead_tuple_len, dead_tuple_count
+	FROM %s.pgstattuple_approx($1::oid::regclass)`
+
+const indexBloatExactSQL string = `
+SELECT index_size, internal_pages, leaf_pages, empty_pages, deleted_pages, avg_leaf_density
+	FROM %s.pgstatindex($1::oid::regclass::text)`
+
+// GetExactBloatStats - Measures bloat using the pgstattuple extension, and uses
+// the estimate for relations that are too large or take too long to measure
+//
+// Falls back to the estimate for all relations when pgstattuple is not installed.
+func GetExactBloatStats(server *state.Server, logger *util.Logger, db *sql.DB, maxSizeBytes int64, timeoutMs int32) (report state.PostgresBloatStats, err error) {
+	report, err = GetBloatStats(logger, db, server.Config.SystemType, server.Config.IgnoreSchemaRegexp)
+	if err != nil {
+		return
+	}
+
+	var pgstattupleSchema string
+	err = db.QueryRow(QueryMarkerSQL + pgstattupleSchemaSQL).Scan(&pgstattupleSchema)
+	if err == sql.ErrNoRows {
+		logger.PrintWarning("pgstattuple extension (1.3 or newer) is not installed in database %s, using bloat estimate instead", report.DatabaseName)
+		err = nil
+		return
+	} else if err != nil {
+		return
+	}
+	pgstattupleSchema = pq.QuoteIdentifier(pgstattupleSchema)
+
+	rows, err := db.Query(QueryMarkerSQL+exactBloatCandidatesSQL, server.Config.IgnoreSchemaRegexp, maxSizeBytes)
+	if err != nil {
+		err = fmt.Errorf("ExactBloat/Query: %s", err)
+		return
+	}
+	defer rows.Close()
+
+	type candidate struct {
+		oid        state.Oid
+		schemaName string
+		name       string
+		isIndex    bool
+		fillfactor int32
+	}
+	var candidates []candidate
+	for rows.Next() {
+		var c candidate
+		err = rows.Scan(&c.oid, &c.schemaName, &c.name, &c.isIndex, &c.fillfactor)
+		if err != nil {
+			err = fmt.Errorf("ExactBloat/Scan: %s", err)
+			return
+		}
+		candidates = append(candidates, c)
+	}
+	rows.Close()
+
+	relations := make(map[string]state.PostgresRelationBloat)
+	indices := make(map[string]state.PostgresIndexBloat)
+	for _, relation := range report.Relations {
+		relations[relation.SchemaName+"."+relation.RelationName] = relation
+	}
+	for _, index := range report.Indices {
+		indices[index.SchemaName+"."+index.IndexName] = index
+	}
+
+	SetStatementTimeout(db, timeoutMs)
+	defer SetDefaultStatementTimeout(db, logger, server)
+
+	for _, c := range candidates {
+		if c.isIndex {
+			index := state.PostgresIndexBloat{SchemaName: c.schemaName, IndexName: c.name, Exact: true}
+			err = db.QueryRow(QueryMarkerSQL+fmt.Sprintf(indexBloatExactSQL, pgstattupleSchema), c.oid).Scan(
+				&index.TotalBytes, &index.InternalPages, &index.LeafPages, &index.EmptyPages,
+				&index.DeletedPages, &index.AvgLeafDensity)
+			if err == nil && !math.IsNaN(index.AvgLeafDensity) {
+				// Leaf pages are only expected to be filled up to the fillfactor
+				index.BloatBytes = int64(float64(index.TotalBytes) * (1.0 - index.AvgLeafDensity/float64(c.fillfactor)))
+				if index.BloatBytes < 0 {
+					index.BloatBytes = 0
+				}
+				indices[c.schemaName+"."+c.name] = index
+			}
+		} else {
+			relation := state.PostgresRelationBloat{SchemaName: c.schemaName, RelationName: c.name, Exact: true}
+			err = db.QueryRow(QueryMarkerSQL+fmt.Sprintf(tableBloatExactSQL, pgstattupleSchema), c.oid).Scan(
+				&relation.TotalBytes, &relation.LiveTupleBytes, &relation.LiveTupleCount,
+				&relation.DeadTupleBytes, &relation.DeadTupleCount)
+			if err == nil {
+				// Pages are only expected to be filled up to the fillfactor, the rest is left for HOT updates
+				relation.BloatBytes = relation.TotalBytes - int64(float64(relation.LiveTupleBytes)*100.0/float64(c.fillfactor))
+				if relation.BloatBytes < 0 {
+					relation.BloatBytes = 0
+				}
+				relations[c.schemaName+"."+c.name] = relation
+			}
+		}
+
+		// Keep the estimate for this relation when it can't be measured, e.g. because it
+		// was dropped in the meantime, or we lack permissions to read it
+		var e *pq.Error
+		if err != nil && errors.As(err, &e) && e.Code == "57014" { // query_canceled
+			logger.PrintVerbose("Timed out measuring bloat of %s.%s, using estimate instead", c.schemaName, c.name)
+		} else if err != nil {
+			logger.PrintWarning("Failed to measure bloat of %s.%s, using estimate instead: %s", c.schemaName, c.name, err)
+		}
+		err = nil
+	}
+
+	report.Relations = nil
+	for _, relation := range relations {
+		if relation.TotalBytes > 0 && relation.BloatBytes > 0 {
+			report.Relations = append(report.Relations, relation)
+		}
+	}
+	report.Indices = nil
+	for _, index := range indices {
+		if index.TotalBytes > 0 && index.BloatBytes > 0 {
+			report.Indices = append(report.Indices, index)
+		}
+	}
+
+	return
+}
@@ -135,6 +135,48 @@ SELECT c.oid,
 			 AND c.oid NOT IN (SELECT relid FROM locked_relids)
 			 AND ($1 = '' OR (n.nspname || '.' || c.relname) !~* $1)`
 
+const triggersSQL string = `
+	WITH locked_relids AS (SELECT DISTINCT relation relid FROM pg_catalog.pg_locks WHERE mode = 'AccessExclusiveLock' AND relation IS NOT NULL)
+SELECT c.oid,
+			 t.tgname,
+			 pg_catalog.pg_get_triggerdef(t.oid, TRUE),
+			 t.tgenabled,
+			 t.tgisinternal,
+			 pn.nspname,
+			 p.proname
+	FROM pg_catalog.pg_trigger t
+			 JOIN pg_catalog.pg_class c ON (t.tgrelid = c.oid)
+			 JOIN pg_catalog.pg_namespace n ON (n.oid = c.relnamespace)
+			 JOIN pg_catalog.pg_proc p ON (t.tgfoid = p.oid)
+			 JOIN pg_catalog.pg_namespace pn ON (pn.oid = p.pronamespace)
+WHERE n.nspname NOT IN ('pg_catalog','pg_toast','information_schema')
+			AND c.oid NOT IN (SELECT relid FROM locked_relids)
+			AND ($1 = '' OR (n.nspname || '.' || c.relname) !~* $1)`
+
+const rulesSQL string = `
+	WITH locked_relids AS (SELECT DISTINCT relation relid FROM pg_catalog.pg_locks WHERE mode = 'AccessExclusiveLock' AND relation IS NOT NULL)
+SELECT c.oid,
+			 r.rulename,
+			 pg_catalog.pg_get_ruledef(r.oid, TRUE),
+			 r.ev_type,
+			 r.is_instead,
+			 r.ev_enabled
+	FROM pg_catalog.pg_rewrite r
+			 JOIN pg_catalog.pg_class c ON (r.ev_class = c.oid)
+			 JOIN pg_catalog.pg_namespace n ON (n.oid = c.relnamespace)
+WHERE r.rulename <> '_RETURN'
+			AND n.nspname NOT IN ('pg_catalog','pg_toast','information_schema')
+			AND c.oid NOT IN (SELECT relid FROM locked_relids)
+			AND ($1 = '' OR (n.nspname || '.' || c.relname) !~* $1)`
+
+const publicationsSQL string = `
+SELECT c.oid,
+			 pt.pubname
+	FROM pg_catalog.pg_publication_tables pt
+			 JOIN pg_catalog.pg_namespace n ON (n.nspname = pt.schemaname)
+			 JOIN pg_catalog.pg_class c ON (c.relnamespace = n.oid AND c.relname = pt.tablename)
+WHERE ($1 = '' OR (n.nspname || '.' || c.relname) !~* $1)`
+
 func GetRelations(db *sql.DB, postgresVersion state.PostgresVersion, currentDatabaseOid state.Oid, ignoreRegexp string) ([]state.PostgresRelation, error) {
 	relations := make(map[state.Oid]state.PostgresRelation, 0)
 
@@ -357,3 +399,105 @@ func GetRelations(db *sql.DB, postgresVersion state.PostgresVersion, currentData
 
 	return v, nil
 }
+
+// GetTriggers - Adds trigger definitions to the relations they are defined on
+func GetTriggers(db *sql.DB, relations []state.PostgresRelation, ignoreRegexp string) error {
+	relationIdxByOid := makeRelationIdxByOid(relations)
+
+	rows, err := db.Query(QueryMarkerSQL+triggersSQL, ignoreRegexp)
+	if err != nil {
+		return fmt.Errorf("Triggers/Query: %s", err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var row state.PostgresTrigger
+
+		err = rows.Scan(&row.RelationOid, &row.Name, &row.Definition, &row.Enabled,
+			&row.IsInternal, &row.FunctionSchema, &row.FunctionName)
+		if err != nil {
+			return fmt.Errorf("Triggers/Scan: %s", err)
+		}
+
+		relIdx, ok := relationIdxByOid[row.RelationOid]
+		if !ok {
+			continue
+		}
+		relations[relIdx].Triggers = append(relations[relIdx].Triggers, row)
+	}
+
+	return rows.Err()
+}
+
+// GetRules - Adds rewrite rule definitions to the relations they are defined on
+func GetRules(db *sql.DB, relations []state.PostgresRelation, ignoreRegexp string) error {
+	relationIdxByOid := makeRelationIdxByOid(relations)
+
+	rows, err := db.Query(QueryMarkerSQL+rulesSQL, ignoreRegexp)
+	if err != nil {
+		return fmt.Errorf("Rules/Query: %s", err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var row state.PostgresRule
+
+		err = rows.Scan(&row.RelationOid, &row.Name, &row.Definition, &row.Event,
+			&row.IsInstead, &row.Enabled)
+		if err != nil {
+			return fmt.Errorf("Rules/Scan: %s", err)
+		}
+
+		relIdx, ok := relationIdxByOid[row.RelationOid]
+		if !ok {
+			continue
+		}
+		relations[relIdx].Rules = append(relations[relIdx].Rules, row)
+	}
+
+	return rows.Err()
+}
+
+// GetPublications - Adds the logical replication publications each relation is part of (Postgres 10+)
+func GetPublications(db *sql.DB, postgresVersion state.PostgresVersion, relations []state.PostgresRelation, ignoreRegexp string) error {
+	if postgresVersion.Numeric < state.PostgresVersion10 {
+		return nil
+	}
+
+	relationIdxByOid := makeRelationIdxByOid(relations)
+
+	rows, err := db.Query(QueryMarkerSQL+publicationsSQL, ignoreRegexp)
+	if err != nil {
+		return fmt.Errorf("Publications/Query: %s", err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var relationOid state.Oid
+		var publicationName string
+
+		err = rows.Scan(&relationOid, &publicationName)
+		if err != nil {
+			return fmt.Errorf("Publications/Scan: %s", err)
+		}
+
+		relIdx, ok := relationIdxByOid[relationOid]
+		if !ok {
+			continue
+		}
+		relations[relIdx].Publications = append(relations[relIdx].Publications, publicationName)
+	}
+
+	return rows.Err()
+}
+
+func makeRelationIdxByOid(relations []state.PostgresRelation) map[state.Oid]int {
+	relationIdxByOid := make(map[state.Oid]int, len(relations))
+	for relIdx, relation := range relations {
+		relationIdxByOid[relation.Oid] = relIdx
+	}
+	return relationIdxByOid
+}
@@ -7,11 +7,12 @@ import (
 	"github.com/pganalyze/collector/util"
 )
 
-func CollectAllSchemas(server *state.Server, collectionOpts state.CollectionOpts, logger *util.Logger, ps state.PersistedState, ts state.TransientState, systemType string) (state.PersistedState, state.TransientState) {
+// SchemaDatabaseNames - Returns the names of all databases we collect schema information from
+func SchemaDatabaseNames(server *state.Server, databases []state.PostgresDatabase, systemType string) []string {
 	schemaDbNames := []string{}
 
 	if server.Config.DbAllNames {
-		for _, database := range ts.Databases {
+		for _, database := range databases {
 			if !database.IsTemplate && database.AllowConnections && !isCloudInternalDatabase(systemType, database.Name) {
 				schemaDbNames = append(schemaDbNames, database.Name)
 			}
@@ -21,10 +22,18 @@ func CollectAllSchemas(server *state.Server, collectionOpts state.CollectionOpts
 		schemaDbNames = append(schemaDbNames, server.Config.DbExtraNames...)
 	}
 
+	return schemaDbNames
+}
+
+func CollectAllSchemas(server *state.Server, collectionOpts state.CollectionOpts, logger *util.Logger, ps state.PersistedState, ts state.TransientState, systemType string) (state.PersistedState, state.TransientState) {
+	schemaDbNames := SchemaDatabaseNames(server, ts.Databases, systemType)
+
 	ps.Relations = []state.PostgresRelation{}
 
 	ps.SchemaStats = make(map[state.Oid]*state.SchemaStats)
 	ps.Functions = []state.PostgresFunction{}
+	ps.Extensions = []state.PostgresExtension{}
+	ps.EventTriggers = []state.PostgresEventTrigger{}
 
 	for _, dbName := range schemaDbNames {
 		schemaConnection, err := EstablishConnection(server, logger, collectionOpts, dbName)
@@ -46,6 +55,9 @@ func CollectAllSchemas(server *state.Server, collectionOpts state.CollectionOpts
 		}
 
 		ps = collectSchemaData(collectionOpts, logger, schemaConnection, ps, databaseOid, dbName, ts.Version, server.Config.IgnoreSchemaRegexp)
+		if server.Config.EnableSecurityInfo {
+			ts.Security = collectSecurityData(logger, schemaConnection, ts.Security, databaseOid, dbName, ts.Version, server.Config.IgnoreSchemaRegexp)
+		}
 		ts.DatabaseOidsWithLocalCatalog = append(ts.DatabaseOidsWithLocalCatalog, databaseOid)
 
 		schemaConnection.Close()
@@ -58,12 +70,58 @@ func CollectAllSchemas(server *state.Server, collectionOpts state.CollectionOpts
 }
 
 func collectSchemaData(collectionOpts state.CollectionOpts, logger *util.Logger, db *sql.DB, ps state.PersistedState, databaseOid state.Oid, databaseName string, postgresVersion state.PostgresVersion, ignoreRegexp string) state.PersistedState {
+	newExtensions, err := GetExtensions(db, databaseOid)
+	if err != nil {
+		logger.PrintWarning("Skipping extension data for database \"%s\", due to error: %s", databaseName, err)
+	} else {
+		for _, extension := range newExtensions {
+			if !extension.Outdated() {
+				continue
+			}
+			format := "Extension \"%s\" in database \"%s\" is outdated: version %s installed, but %s is available (run ALTER EXTENSION %s UPDATE)"
+			if collectionOpts.TestRun {
+				logger.PrintWarning(format, extension.ExtensionName, databaseName, extension.Version, extension.DefaultVersion.String, extension.ExtensionName)
+			} else {
+				logger.PrintVerbose(format, extension.ExtensionName, databaseName, extension.Version, extension.DefaultVersion.String, extension.ExtensionName)
+			}
+		}
+		ps.Extensions = append(ps.Extensions, newExtensions...)
+	}
+
 	if collectionOpts.CollectPostgresRelations {
 		newRelations, err := GetRelations(db, postgresVersion, databaseOid, ignoreRegexp)
 		if err != nil {
 			logger.PrintWarning("Skipping table/index data for database \"%s\", due to error: %s", databaseName, err)
 			return ps
 		}
+
+		err = GetTriggers(db, newRelations, ignoreRegexp)
+		if err != nil {
+			logger.PrintWarning("Skipping triggers for database \"%s\", due to error: %s", databaseName, err)
+		}
+
+		err = GetRules(db, newRelations, ignoreRegexp)
+		if err != nil {
+			logger.PrintWarning("Skipping rules for database \"%s\", due to error: %s", databaseName, err)
+		}
+
+		err = GetPublications(db, postgresVersion, newRelations, ignoreRegexp)
+		if err != nil {
+			logger.PrintWarning("Skipping publications for database \"%s\", due to error: %s", databaseName, err)
+		}
+
+		newEventTriggers, err := GetEventTriggers(db, postgresVersion, databaseOid)
+		if err != nil {
+			logger.PrintWarning("Skipping event triggers for database \"%s\", due to error: %s", databaseName, err)
+		} else {
+			ps.EventTriggers = append(ps.EventTriggers, newEventTriggers...)
+		}
+
+		err = GetColumnStats(logger, db, postgresVersion, newRelations, ignoreRegexp)
+		if err != nil {
+			logger.PrintWarning("Skipping column statistics for database \"%s\", due to error: %s", databaseName, err)
+		}
+
 		ps.Relations = append(ps.Relations, newRelations...)
 
 		newRelationStats, err := GetRelationStats(db, postgresVersion, ignoreRegexp)
@@ -96,3 +154,21 @@ func collectSchemaData(collectionOpts state.CollectionOpts, logger *util.Logger,
 
 	return ps
 }
+
+func collectSecurityData(logger *util.Logger, db *sql.DB, security state.PostgresSecurityInfo, databaseOid state.Oid, databaseName string, postgresVersion state.PostgresVersion, ignoreRegexp string) state.PostgresSecurityInfo {
+	newPrivileges, err := GetPrivileges(db, postgresVersion, databaseOid, ignoreRegexp)
+	if err != nil {
+		logger.PrintWarning("Skipping privileges for database \"%s\", due to error: %s", databaseName, err)
+	} else {
+		security.Privileges = append(security.Privileges, newPrivileges...)
+	}
+
+	newPolicies, err := GetPolicies(db, postgresVersion, databaseOid, ignoreRegexp)
+	if err != nil {
+		logger.PrintWarning("Skipping row level security policies for database \"%s\", due to error: %s", databaseName, err)
+	} else {
+		security.Policies = append(security.Policies, newPolicies...)
+	}
+
+	return security
+}
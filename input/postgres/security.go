@@ -0,0 +1,304 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// Objects without an ACL have the built-in default privileges, which we make
+// explicit via acldefault() so that e.g. EXECUTE granted to PUBLIC on functions
+// shows up in the output
+const privilegesSQL string = `
+SELECT 'table' AS object_type,
+			 c.oid,
+			 n.nspname,
+			 c.relname,
+			 pg_catalog.pg_get_userbyid(a.grantor),
+			 CASE WHEN a.grantee = 0 THEN 'PUBLIC' ELSE pg_catalog.pg_get_userbyid(a.grantee) END,
+			 a.privilege_type,
+			 a.is_grantable
+	FROM pg_catalog.pg_class c
+	JOIN pg_catalog.pg_namespace n ON (n.oid = c.relnamespace),
+			 pg_catalog.aclexplode(COALESCE(c.relacl, pg_catalog.acldefault('r', c.relowner))) a
+ WHERE c.relkind IN ('r','v','m','p','f')
+			 AND c.relpersistence <> 't'
+			 AND n.nspname NOT IN ('pg_catalog','pg_toast','information_schema')
+			 AND ($1 = '' OR (n.nspname || '.' || c.relname) !~* $1)
+ UNION ALL
+SELECT 'schema',
+			 n.oid,
+			 n.nspname,
+			 '',
+			 pg_catalog.pg_get_userbyid(a.grantor),
+			 CASE WHEN a.grantee = 0 THEN 'PUBLIC' ELSE pg_catalog.pg_get_userbyid(a.grantee) END,
+			 a.privilege_type,
+			 a.is_grantable
+	FROM pg_catalog.pg_namespace n,
+			 pg_catalog.aclexplode(COALESCE(n.nspacl, pg_catalog.acldefault('n', n.nspowner))) a
+ WHERE n.nspname NOT IN ('pg_catalog','pg_toast','information_schema')
+			 AND n.nspname NOT LIKE 'pg_temp_%%' AND n.nspname NOT LIKE 'pg_toast_temp_%%'
+ UNION ALL
+SELECT 'function',
+			 p.oid,
+			 n.nspname,
+			 p.proname || '(' || pg_catalog.pg_get_function_identity_arguments(p.oid) || ')',
+			 pg_catalog.pg_get_userbyid(a.grantor),
+			 CASE WHEN a.grantee = 0 THEN 'PUBLIC' ELSE pg_catalog.pg_get_userbyid(a.grantee) END,
+			 a.privilege_type,
+			 a.is_grantable
+	FROM pg_catalog.pg_proc p
+	JOIN pg_catalog.pg_namespace n ON (n.oid = p.pronamespace),
+			 pg_catalog.aclexplode(COALESCE(p.proacl, pg_catalog.acldefault('f', p.proowner))) a
+ WHERE n.nspname NOT IN ('pg_catalog','information_schema')
+			 AND %s
+			 AND ($1 = '' OR (n.nspname || '.' || p.proname) !~* $1)`
+
+const privilegesSQLDefaultFunctionFilter = "NOT p.proisagg"
+const privilegesSQLpg11FunctionFilter = "p.prokind <> 'a'"
+
+const roleMembershipsSQL string = `
+SELECT roleid, member, grantor, admin_option
+	FROM pg_catalog.pg_auth_members`
+
+const policiesSQLDefaultPermissiveField = "true"
+const policiesSQLpg10PermissiveField = "pol.polpermissive"
+
+const policiesSQL string = `
+SELECT c.oid,
+			 n.nspname,
+			 c.relname,
+			 pol.polname,
+			 pol.polcmd,
+			 %s,
+			 (SELECT pg_catalog.array_agg(CASE WHEN r = 0 THEN 'public' ELSE pg_catalog.pg_get_userbyid(r) END)
+					FROM pg_catalog.unnest(pol.polroles) r) AS roles,
+			 pg_catalog.pg_get_expr(pol.polqual, pol.polrelid),
+			 pg_catalog.pg_get_expr(pol.polwithcheck, pol.polrelid)
+	FROM pg_catalog.pg_policy pol
+	JOIN pg_catalog.pg_class c ON (c.oid = pol.polrelid)
+	JOIN pg_catalog.pg_namespace n ON (n.oid = c.relnamespace)
+ WHERE ($1 = '' OR (n.nspname || '.' || c.relname) !~* $1)`
+
+const hbaRulesSQL string = `
+SELECT line_number, type, database, user_name, address, netmask, auth_method, options, error
+	FROM pg_catalog.pg_hba_file_rules
+ ORDER BY line_number`
+
+const passwordMethodsSQL string = `
+SELECT oid,
+			 CASE WHEN rolpassword LIKE 'SCRAM-SHA-256$%' THEN 'scram-sha-256'
+						WHEN rolpassword ~ '^md5[0-9a-f]{32}$' THEN 'md5'
+						ELSE 'plain'
+			 END
+	FROM pg_catalog.pg_authid
+ WHERE rolpassword IS NOT NULL`
+
+// GetPrivileges - Retrieves the decoded ACLs of tables, schemas and functions in the current database
+func GetPrivileges(db *sql.DB, postgresVersion state.PostgresVersion, currentDatabaseOid state.Oid, ignoreRegexp string) ([]state.PostgresPrivilege, error) {
+	var functionFilter string
+
+	if postgresVersion.Numeric >= state.PostgresVersion11 {
+		functionFilter = privilegesSQLpg11FunctionFilter
+	} else {
+		functionFilter = privilegesSQLDefaultFunctionFilter
+	}
+
+	stmt, err := db.Prepare(QueryMarkerSQL + fmt.Sprintf(privilegesSQL, functionFilter))
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(ignoreRegexp)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var privileges []state.PostgresPrivilege
+
+	for rows.Next() {
+		var row state.PostgresPrivilege
+
+		err := rows.Scan(&row.ObjectType, &row.ObjectOid, &row.SchemaName, &row.ObjectName,
+			&row.Grantor, &row.Grantee, &row.Privilege, &row.Grantable)
+		if err != nil {
+			return nil, err
+		}
+
+		row.DatabaseOid = currentDatabaseOid
+
+		privileges = append(privileges, row)
+	}
+
+	return privileges, nil
+}
+
+// GetRoleMemberships - Retrieves all role memberships, including grantor and admin option
+func GetRoleMemberships(db *sql.DB) ([]state.PostgresRoleMembership, error) {
+	rows, err := db.Query(QueryMarkerSQL + roleMembershipsSQL)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var memberships []state.PostgresRoleMembership
+
+	for rows.Next() {
+		var row state.PostgresRoleMembership
+
+		err := rows.Scan(&row.RoleOid, &row.MemberOid, &row.GrantorOid, &row.AdminOption)
+		if err != nil {
+			return nil, err
+		}
+
+		memberships = append(memberships, row)
+	}
+
+	return memberships, nil
+}
+
+// GetPolicies - Retrieves row level security policies in the current database (Postgres 9.5+)
+func GetPolicies(db *sql.DB, postgresVersion state.PostgresVersion, currentDatabaseOid state.Oid, ignoreRegexp string) ([]state.PostgresPolicy, error) {
+	var permissiveField string
+
+	if postgresVersion.Numeric < state.PostgresVersion95 {
+		return nil, nil
+	}
+
+	if postgresVersion.Numeric >= state.PostgresVersion10 {
+		permissiveField = policiesSQLpg10PermissiveField
+	} else {
+		permissiveField = policiesSQLDefaultPermissiveField
+	}
+
+	stmt, err := db.Prepare(QueryMarkerSQL + fmt.Sprintf(policiesSQL, permissiveField))
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(ignoreRegexp)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var policies []state.PostgresPolicy
+
+	for rows.Next() {
+		var row state.PostgresPolicy
+		var roles null.String
+
+		err := rows.Scan(&row.RelationOid, &row.SchemaName, &row.TableName, &row.Name,
+			&row.Command, &row.Permissive, &roles, &row.Using, &row.WithCheck)
+		if err != nil {
+			return nil, err
+		}
+
+		row.DatabaseOid = currentDatabaseOid
+		row.Roles = unpackPostgresStringArray(roles)
+
+		policies = append(policies, row)
+	}
+
+	return policies, nil
+}
+
+// GetHbaRules - Retrieves the parsed contents of pg_hba.conf (Postgres 10+, requires superuser
+// unless access to pg_hba_file_rules was granted explicitly)
+func GetHbaRules(db *sql.DB, postgresVersion state.PostgresVersion) ([]state.PostgresHbaRule, error) {
+	if postgresVersion.Numeric < state.PostgresVersion10 {
+		return nil, nil
+	}
+
+	rows, err := db.Query(QueryMarkerSQL + hbaRulesSQL)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var rules []state.PostgresHbaRule
+
+	for rows.Next() {
+		var row state.PostgresHbaRule
+		var lineNumber null.Int
+		var databases, userNames, options null.String
+
+		err := rows.Scan(&lineNumber, &row.Type, &databases, &userNames, &row.Address,
+			&row.Netmask, &row.AuthMethod, &options, &row.Error)
+		if err != nil {
+			return nil, err
+		}
+
+		row.LineNumber = int32(lineNumber.Int64)
+		row.Databases = unpackPostgresStringArray(databases)
+		row.UserNames = unpackPostgresStringArray(userNames)
+		row.Options = unpackPostgresStringArray(options)
+
+		rules = append(rules, row)
+	}
+
+	return rules, nil
+}
+
+// GetPasswordMethods - Determines how each role's password is stored, based on
+// the format of pg_authid.rolpassword (requires superuser)
+func GetPasswordMethods(db *sql.DB) (map[state.Oid]string, error) {
+	rows, err := db.Query(QueryMarkerSQL + passwordMethodsSQL)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	methods := make(map[state.Oid]string)
+
+	for rows.Next() {
+		var oid state.Oid
+		var method string
+
+		err := rows.Scan(&oid, &method)
+		if err != nil {
+			return nil, err
+		}
+
+		methods[oid] = method
+	}
+
+	return methods, nil
+}
+
+// CollectServerSecurityInfo - Collects the security information that is shared
+// across all databases, failures are logged but otherwise ignored since most of
+// this requires elevated privileges
+func CollectServerSecurityInfo(logger *util.Logger, db *sql.DB, postgresVersion state.PostgresVersion) (security state.PostgresSecurityInfo) {
+	var err error
+
+	security.RoleMemberships, err = GetRoleMemberships(db)
+	if err != nil {
+		logger.PrintWarning("Skipping role memberships, due to error: %s", err)
+	}
+
+	security.HbaRules, err = GetHbaRules(db, postgresVersion)
+	if err != nil {
+		logger.PrintVerbose("Skipping pg_hba.conf rules, due to error: %s", err)
+	}
+
+	security.PasswordMethods, err = GetPasswordMethods(db)
+	if err != nil {
+		logger.PrintVerbose("Skipping password encryption methods, due to error: %s", err)
+	}
+
+	return
+}
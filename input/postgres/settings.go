@@ -7,6 +7,9 @@ import (
 	"github.com/pganalyze/collector/state"
 )
 
+const settingsSQLDefaultOptionalFields = "false"
+const settingsSQLpg95OptionalFields = "pending_restart"
+
 const settingsSQL string = `
 SELECT name,
 			 setting AS current_value,
@@ -15,11 +18,26 @@ SELECT name,
 			 reset_val AS reset_value,
 			 source,
 			 sourcefile,
-			 sourceline
+			 sourceline,
+			 %s
 	FROM pg_catalog.pg_settings`
 
+const settingFileErrorsSQL string = `
+SELECT sourcefile, sourceline, name, error
+	FROM pg_catalog.pg_file_settings
+ WHERE error IS NOT NULL
+ ORDER BY sourcefile, sourceline`
+
 func GetSettings(db *sql.DB, postgresVersion state.PostgresVersion) ([]state.PostgresSetting, error) {
-	stmt, err := db.Prepare(QueryMarkerSQL + settingsSQL)
+	var optionalFields string
+
+	if postgresVersion.Numeric >= state.PostgresVersion95 {
+		optionalFields = settingsSQLpg95OptionalFields
+	} else {
+		optionalFields = settingsSQLDefaultOptionalFields
+	}
+
+	stmt, err := db.Prepare(QueryMarkerSQL + fmt.Sprintf(settingsSQL, optionalFields))
 	if err != nil {
 		err = fmt.Errorf("Settings/Prepare: %s", err)
 		return nil, err
@@ -41,7 +59,8 @@ func GetSettings(db *sql.DB, postgresVersion state.PostgresVersion) ([]state.Pos
 		var row state.PostgresSetting
 
 		err := rows.Scan(&row.Name, &row.CurrentValue, &row.Unit, &row.BootValue,
-			&row.ResetValue, &row.Source, &row.SourceFile, &row.SourceLine)
+			&row.ResetValue, &row.Source, &row.SourceFile, &row.SourceLine,
+			&row.PendingRestart)
 		if err != nil {
 			err = fmt.Errorf("Settings/Scan: %s", err)
 			return nil, err
@@ -52,3 +71,34 @@ func GetSettings(db *sql.DB, postgresVersion state.PostgresVersion) ([]state.Pos
 
 	return settings, nil
 }
+
+// GetSettingFileErrors - Returns configuration file entries that could not be applied (Postgres 9.5+, requires superuser)
+func GetSettingFileErrors(db *sql.DB, postgresVersion state.PostgresVersion) ([]state.PostgresSettingFileError, error) {
+	if postgresVersion.Numeric < state.PostgresVersion95 {
+		return nil, nil
+	}
+
+	rows, err := db.Query(QueryMarkerSQL + settingFileErrorsSQL)
+	if err != nil {
+		err = fmt.Errorf("SettingFileErrors/Query: %s", err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var fileErrors []state.PostgresSettingFileError
+
+	for rows.Next() {
+		var row state.PostgresSettingFileError
+
+		err := rows.Scan(&row.SourceFile, &row.SourceLine, &row.Name, &row.Error)
+		if err != nil {
+			err = fmt.Errorf("SettingFileErrors/Scan: %s", err)
+			return nil, err
+		}
+
+		fileErrors = append(fileErrors, row)
+	}
+
+	return fileErrors, nil
+}
@@ -0,0 +1,278 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// txid_current() would assign a new transaction ID, the snapshot xmax is the next one to be assigned instead
+const xidSampleSQL string = `SELECT pg_catalog.txid_snapshot_xmax(pg_catalog.txid_current_snapshot())`
+
+const mxidSampleSQL string = `SELECT next_multixact_id FROM pg_catalog.pg_control_checkpoint()`
+
+const wraparoundSettingsSQL string = `
+SELECT name, setting
+	FROM pg_catalog.pg_settings
+ WHERE name IN ('autovacuum_freeze_max_age', 'autovacuum_multixact_freeze_max_age')`
+
+const wraparoundDatabasesSQL string = `
+SELECT datname,
+			 pg_catalog.age(datfrozenxid),
+			 %s
+	FROM pg_catalog.pg_database`
+
+const wraparoundRelationsSQL string = `
+SELECT COALESCE(tn.nspname, n.nspname),
+			 COALESCE(t.relname, c.relname),
+			 t.oid IS NOT NULL AS is_toast,
+			 pg_catalog.age(c.relfrozenxid),
+			 %s,
+			 c.reloptions
+	FROM pg_catalog.pg_class c
+	JOIN pg_catalog.pg_namespace n ON (n.oid = c.relnamespace)
+	LEFT JOIN pg_catalog.pg_class t ON (t.reltoastrelid = c.oid)
+	LEFT JOIN pg_catalog.pg_namespace tn ON (tn.oid = t.relnamespace)
+ WHERE c.relkind IN ('r', 'm', 't')
+			 AND c.relfrozenxid <> '0'
+			 AND ($1 = '' OR (COALESCE(tn.nspname, n.nspname) || '.' || COALESCE(t.relname, c.relname)) !~* $1)
+ ORDER BY pg_catalog.age(c.relfrozenxid) DESC
+ LIMIT $2`
+
+const xminHorizonTransactionsSQL string = `
+SELECT pid::text,
+			 datname,
+			 usename,
+			 xact_start,
+			 GREATEST(pg_catalog.age(backend_xmin), pg_catalog.age(backend_xid))
+	FROM %s
+ WHERE (backend_xmin IS NOT NULL OR backend_xid IS NOT NULL)
+			 AND pid <> pg_catalog.pg_backend_pid()
+ ORDER BY 5 DESC
+ LIMIT $1`
+
+const xminHorizonPreparedSQL string = `
+SELECT gid, database, owner, prepared, pg_catalog.age(transaction)
+	FROM pg_catalog.pg_prepared_xacts
+ ORDER BY 5 DESC`
+
+const xminHorizonSlotsSQL string = `
+SELECT slot_name,
+			 database,
+			 NULL::text,
+			 NULL::timestamptz,
+			 GREATEST(pg_catalog.age(xmin), pg_catalog.age(catalog_xmin))
+	FROM pg_catalog.pg_replication_slots
+ WHERE xmin IS NOT NULL OR catalog_xmin IS NOT NULL
+ ORDER BY 5 DESC`
+
+const xminHorizonStandbysSQL string = `
+SELECT COALESCE(NULLIF(application_name, ''), client_addr::text, pid::text),
+			 NULL::text,
+			 usename,
+			 backend_start,
+			 pg_catalog.age(backend_xmin)
+	FROM %s
+ WHERE backend_xmin IS NOT NULL
+ ORDER BY 5 DESC`
+
+// GetXidSample - Retrieves the current position of the transaction ID and multixact ID counters
+func GetXidSample(db *sql.DB, postgresVersion state.PostgresVersion) (sample state.PostgresXidSample, err error) {
+	sample.SampledAt = time.Now()
+
+	err = db.QueryRow(QueryMarkerSQL + xidSampleSQL).Scan(&sample.NextXid)
+	if err != nil {
+		return
+	}
+
+	// pg_control_checkpoint() requires superuser (or an explicit grant), the multixact
+	// rate is simply not available without it
+	if postgresVersion.Numeric >= state.PostgresVersion96 {
+		db.QueryRow(QueryMarkerSQL + mxidSampleSQL).Scan(&sample.NextMultiXactID)
+	}
+
+	return
+}
+
+// GetWraparoundSettings - Returns the autovacuum_freeze_max_age and autovacuum_multixact_freeze_max_age settings
+func GetWraparoundSettings(db *sql.DB) (freezeMaxAge int64, multixactFreezeMaxAge int64, err error) {
+	rows, err := db.Query(QueryMarkerSQL + wraparoundSettingsSQL)
+	if err != nil {
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var name string
+		var value int64
+
+		err = rows.Scan(&name, &value)
+		if err != nil {
+			return
+		}
+
+		switch name {
+		case "autovacuum_freeze_max_age":
+			freezeMaxAge = value
+		case "autovacuum_multixact_freeze_max_age":
+			multixactFreezeMaxAge = value
+		}
+	}
+
+	return
+}
+
+// GetWraparoundDatabases - Returns the transaction ID and multixact ID age of all databases
+func GetWraparoundDatabases(db *sql.DB, postgresVersion state.PostgresVersion) ([]state.PostgresWraparoundDatabase, error) {
+	var mxidAgeField string
+
+	if postgresVersion.Numeric >= state.PostgresVersion95 {
+		mxidAgeField = "pg_catalog.mxid_age(datminmxid)"
+	} else {
+		mxidAgeField = "NULL"
+	}
+
+	rows, err := db.Query(QueryMarkerSQL + fmt.Sprintf(wraparoundDatabasesSQL, mxidAgeField))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var databases []state.PostgresWraparoundDatabase
+
+	for rows.Next() {
+		var row state.PostgresWraparoundDatabase
+
+		err = rows.Scan(&row.DatabaseName, &row.XidAge, &row.MxidAge)
+		if err != nil {
+			return nil, err
+		}
+
+		databases = append(databases, row)
+	}
+
+	return databases, nil
+}
+
+// GetWraparoundRelations - Returns the tables in the current database with the oldest relfrozenxid
+func GetWraparoundRelations(db *sql.DB, postgresVersion state.PostgresVersion, databaseName string, freezeMaxAge int64, ignoreRegexp string, limit int) ([]state.PostgresWraparoundRelation, error) {
+	var mxidAgeField string
+
+	if postgresVersion.Numeric >= state.PostgresVersion95 {
+		mxidAgeField = "pg_catalog.mxid_age(c.relminmxid)"
+	} else {
+		mxidAgeField = "NULL"
+	}
+
+	rows, err := db.Query(QueryMarkerSQL+fmt.Sprintf(wraparoundRelationsSQL, mxidAgeField), ignoreRegexp, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var relations []state.PostgresWraparoundRelation
+
+	for rows.Next() {
+		var row state.PostgresWraparoundRelation
+		var options null.String
+
+		err = rows.Scan(&row.SchemaName, &row.RelationName, &row.IsToast, &row.XidAge, &row.MxidAge, &options)
+		if err != nil {
+			return nil, err
+		}
+
+		row.DatabaseName = databaseName
+		row.AutovacuumFreezeMaxAge = freezeMaxAge
+		for _, option := range unpackPostgresStringArray(options) {
+			if strings.HasPrefix(option, "autovacuum_freeze_max_age=") {
+				// Per-table settings can only lower the effective value
+				value, _ := strconv.ParseInt(strings.TrimPrefix(option, "autovacuum_freeze_max_age="), 10, 64)
+				if value > 0 && value < freezeMaxAge {
+					row.AutovacuumFreezeMaxAge = value
+				}
+			}
+		}
+
+		relations = append(relations, row)
+	}
+
+	return relations, nil
+}
+
+// GetXminHorizonHolders - Returns what currently prevents the xmin horizon from advancing
+func GetXminHorizonHolders(logger *util.Logger, db *sql.DB, postgresVersion state.PostgresVersion, limit int) ([]state.PostgresXminHorizonHolder, error) {
+	var holders []state.PostgresXminHorizonHolder
+	var activitySourceTable, replicationSourceTable string
+
+	if postgresVersion.Numeric < state.PostgresVersion94 {
+		// backend_xmin and replication slots were only added in 9.4
+		return getXminHorizonHolders(db, state.XminHorizonPreparedTransaction, xminHorizonPreparedSQL)
+	}
+
+	if statsHelperExists(db, "get_stat_activity") {
+		activitySourceTable = "pganalyze.get_stat_activity()"
+	} else {
+		activitySourceTable = "pg_catalog.pg_stat_activity"
+	}
+
+	if statsHelperExists(db, "get_stat_replication") {
+		replicationSourceTable = "pganalyze.get_stat_replication()"
+	} else {
+		replicationSourceTable = "pg_catalog.pg_stat_replication"
+	}
+
+	queries := []struct {
+		holderType string
+		sql        string
+		args       []interface{}
+	}{
+		{state.XminHorizonTransaction, fmt.Sprintf(xminHorizonTransactionsSQL, activitySourceTable), []interface{}{limit}},
+		{state.XminHorizonPreparedTransaction, xminHorizonPreparedSQL, nil},
+		{state.XminHorizonReplicationSlot, xminHorizonSlotsSQL, nil},
+		{state.XminHorizonStandbyFeedback, fmt.Sprintf(xminHorizonStandbysSQL, replicationSourceTable), nil},
+	}
+
+	for _, query := range queries {
+		newHolders, err := getXminHorizonHolders(db, query.holderType, query.sql, query.args...)
+		if err != nil {
+			logger.PrintWarning("Skipping %s xmin horizon holders, due to error: %s", query.holderType, err)
+			continue
+		}
+		holders = append(holders, newHolders...)
+	}
+
+	return holders, nil
+}
+
+func getXminHorizonHolders(db *sql.DB, holderType string, query string, args ...interface{}) ([]state.PostgresXminHorizonHolder, error) {
+	rows, err := db.Query(QueryMarkerSQL+query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var holders []state.PostgresXminHorizonHolder
+
+	for rows.Next() {
+		row := state.PostgresXminHorizonHolder{Type: holderType}
+
+		err = rows.Scan(&row.Name, &row.DatabaseName, &row.RoleName, &row.StartedAt, &row.XminAge)
+		if err != nil {
+			return nil, err
+		}
+
+		holders = append(holders, row)
+	}
+
+	return holders, nil
+}
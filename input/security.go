@@ -0,0 +1,40 @@
+package input
+
+import (
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// logSecurityInfo - Summarizes the collected security information, and points out
+// authentication settings that are commonly considered unsafe
+func logSecurityInfo(ts state.TransientState, collectionOpts state.CollectionOpts, logger *util.Logger) {
+	security := ts.Security
+
+	logger.PrintVerbose("Collected security information: %d privileges, %d role memberships, %d row level security policies, %d pg_hba.conf rules",
+		len(security.Privileges), len(security.RoleMemberships), len(security.Policies), len(security.HbaRules))
+
+	printFinding := logger.PrintVerbose
+	if collectionOpts.TestRun {
+		printFinding = logger.PrintWarning
+	}
+
+	for _, rule := range security.HbaRules {
+		if rule.Error.Valid {
+			printFinding("pg_hba.conf line %d has an error and is ignored by the server: %s", rule.LineNumber, rule.Error.String)
+			continue
+		}
+		if rule.Type.String == "local" || !rule.AuthMethod.Valid {
+			continue
+		}
+		if rule.AuthMethod.String == "trust" || rule.AuthMethod.String == "password" {
+			printFinding("pg_hba.conf line %d allows %s connections using \"%s\" authentication", rule.LineNumber, rule.Type.String, rule.AuthMethod.String)
+		}
+	}
+
+	for _, role := range ts.Roles {
+		method, ok := security.PasswordMethods[role.Oid]
+		if ok && role.Login && method != "scram-sha-256" {
+			printFinding("Role \"%s\" can log in and has its password stored using %s instead of scram-sha-256", role.Name, method)
+		}
+	}
+}
@@ -39,9 +39,15 @@ type SystemSample struct {
 	WriteIops         float64 `logfmt:"sample#write-iops"`
 }
 
-func SetupLogReceiver(servers []*state.Server, globalCollectionOpts state.CollectionOpts, logger *util.Logger, herokuLogStream <-chan HerokuLogStreamItem) {
-	go logReceiver(servers, herokuLogStream, globalCollectionOpts, logger)
+// SetupLogReceiver - Processes the log lines received from Heroku log drains, for the
+// servers returned by getServers (which can change with configuration reloads)
+func SetupLogReceiver(getServers func() []*state.Server, globalCollectionOpts state.CollectionOpts, logger *util.Logger, herokuLogStream <-chan HerokuLogStreamItem) {
+	go logReceiver(getServers, herokuLogStream, globalCollectionOpts, logger)
+}
 
+// IdentifyServers - Emits a log line for each server that lets the log receiver match the
+// log drain source to the server
+func IdentifyServers(servers []*state.Server, globalCollectionOpts state.CollectionOpts, logger *util.Logger) {
 	for _, server := range servers {
 		db, err := postgres.EstablishConnection(server, logger, globalCollectionOpts, "")
 		if err == nil {
@@ -195,7 +201,21 @@ func logStreamItemToLogLine(item HerokuLogStreamItem, servers []*state.Server, n
 	return nameToServer, newLogLine, sourceName
 }
 
-func logReceiver(servers []*state.Server, in <-chan HerokuLogStreamItem, globalCollectionOpts state.CollectionOpts, logger *util.Logger) {
+// resolveServers - Replaces the servers matched to log sources with the current servers of
+// the same section, since a configuration reload replaces servers whose configuration changed
+func resolveServers(nameToServer map[string]*state.Server, servers []*state.Server) map[string]*state.Server {
+	resolved := make(map[string]*state.Server)
+	for sourceName, matched := range nameToServer {
+		for _, server := range servers {
+			if server.Config.SectionName == matched.Config.SectionName {
+				resolved[sourceName] = server
+			}
+		}
+	}
+	return resolved
+}
+
+func logReceiver(getServers func() []*state.Server, in <-chan HerokuLogStreamItem, globalCollectionOpts state.CollectionOpts, logger *util.Logger) {
 	var logLinesByName map[string][]state.LogLine
 	var nameToServer map[string]*state.Server
 
@@ -208,6 +228,9 @@ func logReceiver(servers []*state.Server, in <-chan HerokuLogStreamItem, globalC
 			return
 		}
 
+		servers := getServers()
+		nameToServer = resolveServers(nameToServer, servers)
+
 		var newLogLine *state.LogLine
 		var sourceName string
 		nameToServer, newLogLine, sourceName = logStreamItemToLogLine(item, servers, nameToServer, globalCollectionOpts, logger)
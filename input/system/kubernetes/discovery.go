@@ -0,0 +1,447 @@
+package kubernetes
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/util"
+)
+
+const serviceAccountDir = "/var/run/secrets/kubernetes.io/serviceaccount"
+
+type objectMeta struct {
+	Name        string            `json:"name"`
+	Namespace   string            `json:"namespace"`
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+}
+
+type serviceList struct {
+	Items []struct {
+		Metadata objectMeta `json:"metadata"`
+		Spec     struct {
+			Ports []struct {
+				Name string `json:"name"`
+				Port int    `json:"port"`
+			} `json:"ports"`
+		} `json:"spec"`
+	} `json:"items"`
+}
+
+type podList struct {
+	Items []struct {
+		Metadata struct {
+			objectMeta
+			DeletionTimestamp string `json:"deletionTimestamp"`
+		} `json:"metadata"`
+		Spec struct {
+			Containers []struct {
+				Ports []struct {
+					Name          string `json:"name"`
+					ContainerPort int    `json:"containerPort"`
+				} `json:"ports"`
+			} `json:"containers"`
+		} `json:"spec"`
+		Status struct {
+			Phase string `json:"phase"`
+			PodIP string `json:"podIP"`
+		} `json:"status"`
+	} `json:"items"`
+}
+
+type cloudNativePGClusterList struct {
+	Items []struct {
+		Metadata objectMeta `json:"metadata"`
+		Spec     struct {
+			Bootstrap struct {
+				Initdb struct {
+					Database string `json:"database"`
+				} `json:"initdb"`
+			} `json:"bootstrap"`
+		} `json:"spec"`
+	} `json:"items"`
+}
+
+type zalandoPostgresqlList struct {
+	Items []struct {
+		Metadata objectMeta `json:"metadata"`
+		Spec     struct {
+			Databases map[string]string `json:"databases"`
+		} `json:"spec"`
+	} `json:"items"`
+}
+
+type crunchyDataPostgresClusterList struct {
+	Items []struct {
+		Metadata objectMeta `json:"metadata"`
+	} `json:"items"`
+}
+
+type secret struct {
+	Data map[string][]byte `json:"data"`
+}
+
+// discoveredResource - Postgres instance found in the Kubernetes API, before its secret
+// has been read
+type discoveredResource struct {
+	instance   config.DiscoveredInstance
+	secretName string
+
+	// Whether the secret is referenced by the resource itself, and should be used
+	// even if discover_kubernetes_secret is set
+	secretFromAnnotation bool
+}
+
+// DiscoverInstances - Finds all Postgres instances in Kubernetes that match the settings
+// of the discovery section, and reads their credentials from the referenced secrets
+func DiscoverInstances(discovery config.ServerConfig, logger *util.Logger) ([]config.DiscoveredInstance, error) {
+	client, err := newAPIClient(discovery)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace := discovery.DiscoverKubernetesNamespace
+	if namespace == "" {
+		data, err := ioutil.ReadFile(serviceAccountDir + "/namespace")
+		if err != nil {
+			return nil, fmt.Errorf("discover_kubernetes_namespace needs to be set when not running inside Kubernetes")
+		}
+		namespace = strings.TrimSpace(string(data))
+	}
+	if namespace == "*" {
+		namespace = ""
+	}
+
+	var resources []discoveredResource
+	switch discovery.DiscoverKubernetesKind {
+	case "", "service":
+		resources, err = discoverServices(client, namespace, discovery.DiscoverKubernetesLabelSelector)
+	case "pod":
+		resources, err = discoverPods(client, namespace, discovery.DiscoverKubernetesLabelSelector)
+	case "cloudnativepg":
+		resources, err = discoverCloudNativePGClusters(client, namespace, discovery.DiscoverKubernetesLabelSelector)
+	case "zalando":
+		resources, err = discoverZalandoPostgresqls(client, namespace, discovery.DiscoverKubernetesLabelSelector)
+	case "crunchydata":
+		resources, err = discoverCrunchyDataPostgresClusters(client, namespace, discovery.DiscoverKubernetesLabelSelector)
+	default:
+		return nil, fmt.Errorf("unsupported discover_kubernetes_kind \"%s\"", discovery.DiscoverKubernetesKind)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var instances []config.DiscoveredInstance
+	for _, resource := range resources {
+		instance := resource.instance
+		secretName := resource.secretName
+		if discovery.DiscoverKubernetesSecret != "" && !resource.secretFromAnnotation {
+			secretName = strings.NewReplacer("{name}", instance.ID, "{namespace}", instance.Namespace).Replace(discovery.DiscoverKubernetesSecret)
+		}
+
+		if secretName != "" {
+			found, err := readSecret(client, &instance, secretName)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read secret %s/%s: %s", instance.Namespace, secretName, err)
+			}
+			if !found {
+				logger.PrintWarning("Skipping %s/%s, since its secret %s does not exist (yet)", instance.Namespace, instance.ID, secretName)
+				continue
+			}
+		}
+
+		instances = append(instances, instance)
+	}
+
+	return instances, nil
+}
+
+func discoverServices(client *apiClient, namespace string, labelSelector string) ([]discoveredResource, error) {
+	var list serviceList
+	err := client.list("", "services", namespace, labelSelector, &list)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []discoveredResource
+	for _, item := range list.Items {
+		port := 0
+		for _, p := range item.Spec.Ports {
+			if p.Name == "postgres" || p.Name == "postgresql" || (port == 0 && p.Port == 5432) {
+				port = p.Port
+			}
+		}
+		if port == 0 && len(item.Spec.Ports) > 0 {
+			port = item.Spec.Ports[0].Port
+		}
+
+		// Services can reference their secret using the "pganalyze.com/secret" annotation
+		resource := newResource(item.Metadata, item.Metadata.Name, port)
+		resource.secretName = item.Metadata.Annotations["pganalyze.com/secret"]
+		resource.secretFromAnnotation = resource.secretName != ""
+		resources = append(resources, resource)
+	}
+	return resources, nil
+}
+
+// discoverPods - Finds running pods, which are connected to directly using their IP (e.g. for
+// StatefulSets without a Service for each member)
+func discoverPods(client *apiClient, namespace string, labelSelector string) ([]discoveredResource, error) {
+	var list podList
+	err := client.list("", "pods", namespace, labelSelector, &list)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []discoveredResource
+	for _, item := range list.Items {
+		// Pods that are starting up or shutting down are picked up by a later discovery run
+		if item.Status.Phase != "Running" || item.Status.PodIP == "" || item.Metadata.DeletionTimestamp != "" {
+			continue
+		}
+
+		port := 0
+		for _, container := range item.Spec.Containers {
+			for _, p := range container.Ports {
+				if p.Name == "postgres" || p.Name == "postgresql" || (port == 0 && p.ContainerPort == 5432) {
+					port = p.ContainerPort
+				}
+			}
+		}
+		if port == 0 {
+			port = 5432
+		}
+
+		resource := newResource(item.Metadata.objectMeta, item.Metadata.Name, port)
+		resource.instance.Host = item.Status.PodIP
+		resource.instance.SystemID = item.Metadata.Name + "." + item.Metadata.Namespace + ".pod"
+		resource.secretName = item.Metadata.Annotations["pganalyze.com/secret"]
+		resource.secretFromAnnotation = resource.secretName != ""
+		resources = append(resources, resource)
+	}
+	return resources, nil
+}
+
+func discoverCloudNativePGClusters(client *apiClient, namespace string, labelSelector string) ([]discoveredResource, error) {
+	var list cloudNativePGClusterList
+	err := client.list("postgresql.cnpg.io/v1", "clusters", namespace, labelSelector, &list)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []discoveredResource
+	for _, item := range list.Items {
+		resource := newResource(item.Metadata, item.Metadata.Name+"-rw", 5432)
+		resource.instance.DbName = item.Spec.Bootstrap.Initdb.Database
+		if resource.instance.DbName == "" {
+			resource.instance.DbName = "app"
+		}
+		resource.secretName = item.Metadata.Name + "-app"
+		resources = append(resources, resource)
+	}
+	return resources, nil
+}
+
+func discoverZalandoPostgresqls(client *apiClient, namespace string, labelSelector string) ([]discoveredResource, error) {
+	var list zalandoPostgresqlList
+	err := client.list("acid.zalan.do/v1", "postgresqls", namespace, labelSelector, &list)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []discoveredResource
+	for _, item := range list.Items {
+		var dbNames []string
+		for dbName := range item.Spec.Databases {
+			dbNames = append(dbNames, dbName)
+		}
+		sort.Strings(dbNames)
+
+		resource := newResource(item.Metadata, item.Metadata.Name, 5432)
+		resource.instance.DbName = strings.Join(dbNames, ",")
+		resource.secretName = "postgres." + item.Metadata.Name + ".credentials.postgresql.acid.zalan.do"
+		resources = append(resources, resource)
+	}
+	return resources, nil
+}
+
+func discoverCrunchyDataPostgresClusters(client *apiClient, namespace string, labelSelector string) ([]discoveredResource, error) {
+	var list crunchyDataPostgresClusterList
+	err := client.list("postgres-operator.crunchydata.com/v1beta1", "postgresclusters", namespace, labelSelector, &list)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []discoveredResource
+	for _, item := range list.Items {
+		resource := newResource(item.Metadata, item.Metadata.Name+"-primary", 5432)
+		resource.secretName = item.Metadata.Name + "-pguser-" + item.Metadata.Name
+		resources = append(resources, resource)
+	}
+	return resources, nil
+}
+
+func newResource(metadata objectMeta, serviceName string, port int) discoveredResource {
+	tags := make(map[string]string)
+	for key, value := range metadata.Labels {
+		tags[key] = value
+	}
+
+	return discoveredResource{
+		instance: config.DiscoveredInstance{
+			ID:        metadata.Name,
+			ClusterID: metadata.Name,
+			Namespace: metadata.Namespace,
+			Host:      serviceName + "." + metadata.Namespace + ".svc",
+			Port:      port,
+			Tags:      tags,
+		},
+	}
+}
+
+// readSecret - Reads the credentials (and connection settings, if present) of the instance
+// from the secret, returning false if the secret does not exist
+func readSecret(client *apiClient, instance *config.DiscoveredInstance, name string) (bool, error) {
+	var s secret
+	err := client.get("/api/v1/namespaces/"+url.PathEscape(instance.Namespace)+"/secrets/"+url.PathEscape(name), nil, &s)
+	if err == errNotFound {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	value := func(keys ...string) string {
+		for _, key := range keys {
+			if v, ok := s.Data[key]; ok {
+				return string(v)
+			}
+		}
+		return ""
+	}
+
+	if username := value("username", "user"); username != "" {
+		instance.DbUsername = username
+	}
+	instance.DbPassword = value("password")
+	if dbName := value("dbname", "database"); dbName != "" {
+		instance.DbName = dbName
+	}
+	if host := value("host"); host != "" {
+		instance.Host = host
+	}
+	if port, err := strconv.Atoi(value("port")); err == nil {
+		instance.Port = port
+	}
+	return true, nil
+}
+
+var errNotFound = errors.New("not found")
+
+type apiClient struct {
+	baseURL    string
+	tokenFile  string
+	httpClient *http.Client
+}
+
+// newAPIClient - Connects to the Kubernetes API of the cluster the collector runs in, using
+// its service account, or to the API at discover_kubernetes_api_url (e.g. "kubectl proxy")
+func newAPIClient(discovery config.ServerConfig) (*apiClient, error) {
+	client := &apiClient{
+		baseURL:   strings.TrimSuffix(discovery.DiscoverKubernetesAPIURL, "/"),
+		tokenFile: serviceAccountDir + "/token",
+	}
+	if client.baseURL == "" {
+		host := os.Getenv("KUBERNETES_SERVICE_HOST")
+		port := os.Getenv("KUBERNETES_SERVICE_PORT")
+		if host == "" || port == "" {
+			return nil, fmt.Errorf("not running inside Kubernetes (KUBERNETES_SERVICE_HOST is not set), set discover_kubernetes_api_url instead")
+		}
+		client.baseURL = "https://" + net.JoinHostPort(host, port)
+	}
+
+	transport := &http.Transport{
+		Proxy:               http.ProxyFromEnvironment,
+		TLSHandshakeTimeout: 10 * time.Second,
+	}
+	if caCert, err := ioutil.ReadFile(serviceAccountDir + "/ca.crt"); err == nil {
+		pool := x509.NewCertPool()
+		pool.AppendCertsFromPEM(caCert)
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+	}
+	client.httpClient = &http.Client{Transport: transport, Timeout: 30 * time.Second}
+
+	return client, nil
+}
+
+func (client *apiClient) list(groupVersion string, resource string, namespace string, labelSelector string, result interface{}) error {
+	path := "/api/v1"
+	if groupVersion != "" {
+		path = "/apis/" + groupVersion
+	}
+	if namespace != "" {
+		path += "/namespaces/" + url.PathEscape(namespace)
+	}
+	path += "/" + resource
+
+	query := url.Values{}
+	if labelSelector != "" {
+		query.Set("labelSelector", labelSelector)
+	}
+
+	err := client.get(path, query, result)
+	if err == errNotFound {
+		return fmt.Errorf("%s are not available in this cluster (is the operator installed?)", resource)
+	} else if err != nil {
+		return fmt.Errorf("failed to list %s: %s", resource, err)
+	}
+	return nil
+}
+
+func (client *apiClient) get(path string, query url.Values, result interface{}) error {
+	requestURL := client.baseURL + path
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+	req, err := http.NewRequest("GET", requestURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// The token is read again for every request, since Kubernetes rotates it
+	if token, err := ioutil.ReadFile(client.tokenFile); err == nil {
+		req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	}
+
+	resp, err := client.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return errNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	return json.Unmarshal(body, result)
+}
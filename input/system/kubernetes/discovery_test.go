@@ -0,0 +1,210 @@
+package kubernetes_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/input/system/kubernetes"
+	"github.com/pganalyze/collector/util"
+)
+
+// Secret values are base64 encoded: "pganalyze", "secret", "app", "app-password"
+var kubernetesResponses = map[string]string{
+	"/api/v1/namespaces/db/services?labelSelector=pganalyze%3Dtrue": `{"items": [
+		{"metadata": {"name": "orders", "namespace": "db", "labels": {"pganalyze": "true", "team": "checkout"}, "annotations": {"pganalyze.com/secret": "orders-monitoring"}},
+		 "spec": {"ports": [{"name": "metrics", "port": 9187}, {"name": "postgres", "port": 5433}]}},
+		{"metadata": {"name": "search", "namespace": "db", "labels": {"pganalyze": "true"}},
+		 "spec": {"ports": [{"port": 5432}]}},
+		{"metadata": {"name": "new", "namespace": "db", "labels": {"pganalyze": "true"}},
+		 "spec": {"ports": [{"port": 5432}]}}
+	]}`,
+	"/apis/postgresql.cnpg.io/v1/namespaces/db/clusters": `{"items": [
+		{"metadata": {"name": "billing", "namespace": "db"}, "spec": {"bootstrap": {"initdb": {"database": "billing"}}}}
+	]}`,
+	"/api/v1/namespaces/db/pods?labelSelector=app%3Dpostgres": `{"items": [
+		{"metadata": {"name": "pg-0", "namespace": "db", "labels": {"app": "postgres"}, "annotations": {"pganalyze.com/secret": "pg-monitoring"}},
+		 "spec": {"containers": [{"ports": [{"name": "exporter", "containerPort": 9187}, {"name": "postgres", "containerPort": 5433}]}]},
+		 "status": {"phase": "Running", "podIP": "10.0.0.12"}},
+		{"metadata": {"name": "pg-1", "namespace": "db", "labels": {"app": "postgres"}, "annotations": {"pganalyze.com/secret": "pg-monitoring"}},
+		 "spec": {"containers": [{"ports": [{"containerPort": 5432}]}]},
+		 "status": {"phase": "Pending"}},
+		{"metadata": {"name": "pg-2", "namespace": "db", "labels": {"app": "postgres"}, "annotations": {"pganalyze.com/secret": "pg-monitoring"}, "deletionTimestamp": "2024-01-01T00:00:00Z"},
+		 "spec": {"containers": [{"ports": [{"containerPort": 5432}]}]},
+		 "status": {"phase": "Running", "podIP": "10.0.0.14"}}
+	]}`,
+	"/api/v1/namespaces/db/secrets/pg-monitoring":     `{"data": {"username": "cGdhbmFseXpl", "password": "c2VjcmV0"}}`,
+	"/api/v1/namespaces/db/secrets/orders-monitoring": `{"data": {"username": "cGdhbmFseXpl", "password": "c2VjcmV0"}}`,
+	"/api/v1/namespaces/db/secrets/search-pganalyze":  `{"data": {"user": "cGdhbmFseXpl", "password": "c2VjcmV0"}}`,
+	"/api/v1/namespaces/db/secrets/billing-app":       `{"data": {"username": "YXBw", "password": "YXBwLXBhc3N3b3Jk", "dbname": "YXBw", "port": "NTQzMg=="}}`,
+}
+
+func fakeKubernetesAPI(w http.ResponseWriter, r *http.Request) {
+	response, ok := kubernetesResponses[r.URL.RequestURI()]
+	if !ok || response == "" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	fmt.Fprint(w, response)
+}
+
+func TestDiscoverServices(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(fakeKubernetesAPI))
+	defer server.Close()
+
+	discovery := config.ServerConfig{
+		SectionName:                     "discover_kubernetes",
+		DiscoverKubernetesAPIURL:        server.URL,
+		DiscoverKubernetesNamespace:     "db",
+		DiscoverKubernetesLabelSelector: "pganalyze=true",
+		DiscoverKubernetesSecret:        "{name}-pganalyze",
+	}
+
+	instances, err := kubernetes.DiscoverInstances(discovery, &util.Logger{Destination: log.New(ioutil.Discard, "", 0)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The "new" service is skipped, since its secret does not exist yet
+	expected := []config.DiscoveredInstance{
+		{
+			ID:         "orders",
+			ClusterID:  "orders",
+			Namespace:  "db",
+			Host:       "orders.db.svc",
+			Port:       5433,
+			Tags:       map[string]string{"pganalyze": "true", "team": "checkout"},
+			DbUsername: "pganalyze",
+			DbPassword: "secret",
+		},
+		{
+			ID:         "search",
+			ClusterID:  "search",
+			Namespace:  "db",
+			Host:       "search.db.svc",
+			Port:       5432,
+			Tags:       map[string]string{"pganalyze": "true"},
+			DbUsername: "pganalyze",
+			DbPassword: "secret",
+		},
+	}
+	if diff := pretty.Compare(expected, instances); diff != "" {
+		t.Errorf("Unexpected discovered instances, diff: (-want +got)\n%s", diff)
+	}
+}
+
+func TestDiscoverPods(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(fakeKubernetesAPI))
+	defer server.Close()
+
+	discovery := config.ServerConfig{
+		SectionName:                     "discover_kubernetes",
+		DiscoverKubernetesAPIURL:        server.URL,
+		DiscoverKubernetesNamespace:     "db",
+		DiscoverKubernetesKind:          "pod",
+		DiscoverKubernetesLabelSelector: "app=postgres",
+	}
+	logger := &util.Logger{Destination: log.New(ioutil.Discard, "", 0)}
+
+	instances, err := kubernetes.DiscoverInstances(discovery, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Pods that are not running yet, or are being deleted, are skipped
+	expected := []config.DiscoveredInstance{
+		{
+			ID:         "pg-0",
+			ClusterID:  "pg-0",
+			Namespace:  "db",
+			Host:       "10.0.0.12",
+			Port:       5433,
+			Tags:       map[string]string{"app": "postgres"},
+			DbUsername: "pganalyze",
+			DbPassword: "secret",
+			SystemID:   "pg-0.db.pod",
+		},
+	}
+	if diff := pretty.Compare(expected, instances); diff != "" {
+		t.Errorf("Unexpected discovered instances, diff: (-want +got)\n%s", diff)
+	}
+
+	before, err := config.DiscoveredServerConfig(discovery, instances[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if before.SystemID != "pg-0.db.pod" {
+		t.Errorf("Expected the pod name to identify the system instead of its IP, got %s", before.SystemID)
+	}
+
+	// A rotated password is picked up by the next discovery run, and changes the server config
+	secretPath := "/api/v1/namespaces/db/secrets/pg-monitoring"
+	previousSecret := kubernetesResponses[secretPath]
+	kubernetesResponses[secretPath] = `{"data": {"username": "cGdhbmFseXpl", "password": "cm90YXRlZA=="}}`
+	defer func() { kubernetesResponses[secretPath] = previousSecret }()
+
+	instances, err = kubernetes.DiscoverInstances(discovery, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	after, err := config.DiscoveredServerConfig(discovery, instances[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after.DbPassword != "rotated" || after.Equal(before) {
+		t.Errorf("Expected rotated password to change the server config, got %+v", after)
+	}
+}
+
+func TestDiscoverCloudNativePG(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(fakeKubernetesAPI))
+	defer server.Close()
+
+	discovery := config.ServerConfig{
+		SectionName:                 "discover_kubernetes",
+		DiscoverKubernetesAPIURL:    server.URL,
+		DiscoverKubernetesNamespace: "db",
+		DiscoverKubernetesKind:      "cloudnativepg",
+	}
+
+	instances, err := kubernetes.DiscoverInstances(discovery, &util.Logger{Destination: log.New(ioutil.Discard, "", 0)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []config.DiscoveredInstance{
+		{
+			ID:         "billing",
+			ClusterID:  "billing",
+			Namespace:  "db",
+			Host:       "billing-rw.db.svc",
+			Port:       5432,
+			Tags:       map[string]string{},
+			DbName:     "app",
+			DbUsername: "app",
+			DbPassword: "app-password",
+		},
+	}
+	if diff := pretty.Compare(expected, instances); diff != "" {
+		t.Errorf("Unexpected discovered instances, diff: (-want +got)\n%s", diff)
+	}
+
+	server2, err := config.DiscoveredServerConfig(config.ServerConfig{SectionName: "discover_kubernetes", DbUsername: "pganalyze"}, instances[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if server2.SectionName != "discover_kubernetes/db/billing" || server2.DbUsername != "pganalyze" || server2.DbPassword != "app-password" || server2.SystemType != "self_hosted" || server2.SystemID != "billing-rw.db.svc" {
+		t.Errorf("Unexpected discovered server config: %+v", server2)
+	}
+
+	discovery.DiscoverKubernetesKind = "zalando"
+	_, err = kubernetes.DiscoverInstances(discovery, &util.Logger{Destination: log.New(ioutil.Discard, "", 0)})
+	if err == nil || err.Error() != "postgresqls are not available in this cluster (is the operator installed?)" {
+		t.Errorf("Expected error for missing custom resource, got %v", err)
+	}
+}
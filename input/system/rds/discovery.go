@@ -0,0 +1,135 @@
+package rds
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/util"
+	"github.com/pganalyze/collector/util/awsutil"
+)
+
+// DiscoverInstances - Finds all Postgres instances (including Aurora) in the region of the
+// discovery section, whose tags (or the tags of their Aurora cluster) match discover_rds_tags
+func DiscoverInstances(discovery config.ServerConfig, logger *util.Logger) ([]config.DiscoveredInstance, error) {
+	if discovery.AwsRegion == "" {
+		return nil, fmt.Errorf("aws_region needs to be set")
+	}
+
+	sess, err := awsutil.GetAwsSession(discovery)
+	if err != nil {
+		return nil, err
+	}
+	svc := rds.New(sess)
+	requiredTags := parseTagFilter(discovery.DiscoverRdsTags)
+
+	clusterTags := make(map[string]map[string]string)
+	err = svc.DescribeDBClustersPages(&rds.DescribeDBClustersInput{}, func(resp *rds.DescribeDBClustersOutput, lastPage bool) bool {
+		for _, cluster := range resp.DBClusters {
+			if util.StringPtrToString(cluster.Engine) != "aurora-postgresql" {
+				continue
+			}
+			tags, tagErr := listTags(svc, cluster.DBClusterArn)
+			if tagErr != nil {
+				err = tagErr
+				return false
+			}
+			clusterTags[util.StringPtrToString(cluster.DBClusterIdentifier)] = tags
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe DB clusters: %s", err)
+	}
+
+	var instances []config.DiscoveredInstance
+	err = svc.DescribeDBInstancesPages(&rds.DescribeDBInstancesInput{}, func(resp *rds.DescribeDBInstancesOutput, lastPage bool) bool {
+		for _, dbInstance := range resp.DBInstances {
+			engine := util.StringPtrToString(dbInstance.Engine)
+			if engine != "postgres" && engine != "aurora-postgresql" {
+				continue
+			}
+			if dbInstance.Endpoint == nil || dbInstance.Endpoint.Address == nil {
+				logger.PrintVerbose("Skipping RDS instance %s without endpoint (status: %s)", util.StringPtrToString(dbInstance.DBInstanceIdentifier), util.StringPtrToString(dbInstance.DBInstanceStatus))
+				continue
+			}
+
+			// Instance tags take precedence over the tags of the cluster
+			instance := config.DiscoveredInstance{
+				ID:        util.StringPtrToString(dbInstance.DBInstanceIdentifier),
+				ClusterID: util.StringPtrToString(dbInstance.DBClusterIdentifier),
+				Region:    discovery.AwsRegion,
+				Host:      util.StringPtrToString(dbInstance.Endpoint.Address),
+				Port:      int(util.IntPtrToInt(dbInstance.Endpoint.Port)),
+				Tags:      make(map[string]string),
+			}
+			for key, value := range clusterTags[instance.ClusterID] {
+				instance.Tags[key] = value
+			}
+			tags, tagErr := listTags(svc, dbInstance.DBInstanceArn)
+			if tagErr != nil {
+				err = tagErr
+				return false
+			}
+			for key, value := range tags {
+				instance.Tags[key] = value
+			}
+
+			if matchesTagFilter(instance.Tags, requiredTags) {
+				instances = append(instances, instance)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe DB instances: %s", err)
+	}
+
+	return instances, nil
+}
+
+func listTags(svc *rds.RDS, arn *string) (map[string]string, error) {
+	tags := make(map[string]string)
+	if arn == nil {
+		return tags, nil
+	}
+
+	resp, err := svc.ListTagsForResource(&rds.ListTagsForResourceInput{ResourceName: arn})
+	if err != nil {
+		return nil, err
+	}
+	for _, tag := range resp.TagList {
+		tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return tags, nil
+}
+
+// parseTagFilter - Parses "key=value,key2" into a map, with an empty value for tags that only need to exist
+func parseTagFilter(filter string) map[string]*string {
+	tags := make(map[string]*string)
+	for _, part := range strings.Split(filter, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		keyValue := strings.SplitN(part, "=", 2)
+		if len(keyValue) == 2 {
+			tags[strings.TrimSpace(keyValue[0])] = aws.String(strings.TrimSpace(keyValue[1]))
+		} else {
+			tags[part] = nil
+		}
+	}
+	return tags
+}
+
+func matchesTagFilter(tags map[string]string, requiredTags map[string]*string) bool {
+	for key, requiredValue := range requiredTags {
+		value, exists := tags[key]
+		if !exists || (requiredValue != nil && value != *requiredValue) {
+			return false
+		}
+	}
+	return true
+}
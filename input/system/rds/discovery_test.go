@@ -0,0 +1,96 @@
+package rds_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/input/system/rds"
+	"github.com/pganalyze/collector/util"
+)
+
+const rdsXMLNS = "http://rds.amazonaws.com/doc/2014-10-31/"
+
+const describeDBClustersResponse = `<DescribeDBClustersResponse xmlns="` + rdsXMLNS + `"><DescribeDBClustersResult><DBClusters>
+<DBCluster><DBClusterIdentifier>aurora1</DBClusterIdentifier><DBClusterArn>arn:cluster:aurora1</DBClusterArn><Engine>aurora-postgresql</Engine></DBCluster>
+</DBClusters></DescribeDBClustersResult></DescribeDBClustersResponse>`
+
+const describeDBInstancesResponse = `<DescribeDBInstancesResponse xmlns="` + rdsXMLNS + `"><DescribeDBInstancesResult><DBInstances>
+<DBInstance><DBInstanceIdentifier>aurora1-instance1</DBInstanceIdentifier><DBClusterIdentifier>aurora1</DBClusterIdentifier><DBInstanceArn>arn:db:aurora1-instance1</DBInstanceArn><Engine>aurora-postgresql</Engine><Endpoint><Address>aurora1-instance1.abc.us-east-1.rds.amazonaws.com</Address><Port>5432</Port></Endpoint></DBInstance>
+<DBInstance><DBInstanceIdentifier>prod1</DBInstanceIdentifier><DBInstanceArn>arn:db:prod1</DBInstanceArn><Engine>postgres</Engine><Endpoint><Address>prod1.abc.us-east-1.rds.amazonaws.com</Address><Port>5433</Port></Endpoint></DBInstance>
+<DBInstance><DBInstanceIdentifier>staging1</DBInstanceIdentifier><DBInstanceArn>arn:db:staging1</DBInstanceArn><Engine>postgres</Engine><Endpoint><Address>staging1.abc.us-east-1.rds.amazonaws.com</Address><Port>5432</Port></Endpoint></DBInstance>
+<DBInstance><DBInstanceIdentifier>mysql1</DBInstanceIdentifier><DBInstanceArn>arn:db:mysql1</DBInstanceArn><Engine>mysql</Engine><Endpoint><Address>mysql1.abc.us-east-1.rds.amazonaws.com</Address><Port>3306</Port></Endpoint></DBInstance>
+</DBInstances></DescribeDBInstancesResult></DescribeDBInstancesResponse>`
+
+var resourceTags = map[string]string{
+	"arn:cluster:aurora1":      `<Tag><Key>env</Key><Value>production</Value></Tag><Tag><Key>team</Key><Value>payments</Value></Tag>`,
+	"arn:db:aurora1-instance1": ``,
+	"arn:db:prod1":             `<Tag><Key>env</Key><Value>production</Value></Tag><Tag><Key>team</Key><Value>search</Value></Tag>`,
+	"arn:db:staging1":          `<Tag><Key>env</Key><Value>staging</Value></Tag><Tag><Key>team</Key><Value>search</Value></Tag>`,
+	"arn:db:mysql1":            `<Tag><Key>env</Key><Value>production</Value></Tag>`,
+}
+
+func fakeRdsAPI(w http.ResponseWriter, r *http.Request) {
+	r.ParseForm()
+	switch r.Form.Get("Action") {
+	case "DescribeDBClusters":
+		fmt.Fprint(w, describeDBClustersResponse)
+	case "DescribeDBInstances":
+		fmt.Fprint(w, describeDBInstancesResponse)
+	case "ListTagsForResource":
+		fmt.Fprintf(w, `<ListTagsForResourceResponse xmlns="%s"><ListTagsForResourceResult><TagList>%s</TagList></ListTagsForResourceResult></ListTagsForResourceResponse>`, rdsXMLNS, resourceTags[r.Form.Get("ResourceName")])
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func TestDiscoverInstances(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(fakeRdsAPI))
+	defer server.Close()
+
+	discovery := config.ServerConfig{
+		SectionName:        "discover_rds",
+		AwsRegion:          "us-east-1",
+		AwsAccessKeyID:     "test",
+		AwsSecretAccessKey: "test",
+		AwsEndpointRdsURL:  server.URL,
+		DiscoverRdsTags:    "env=production,team",
+	}
+
+	instances, err := rds.DiscoverInstances(discovery, &util.Logger{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []config.DiscoveredInstance{
+		{
+			ID:        "aurora1-instance1",
+			ClusterID: "aurora1",
+			Region:    "us-east-1",
+			Host:      "aurora1-instance1.abc.us-east-1.rds.amazonaws.com",
+			Port:      5432,
+			Tags:      map[string]string{"env": "production", "team": "payments"},
+		},
+		{
+			ID:     "prod1",
+			Region: "us-east-1",
+			Host:   "prod1.abc.us-east-1.rds.amazonaws.com",
+			Port:   5433,
+			Tags:   map[string]string{"env": "production", "team": "search"},
+		},
+	}
+	if diff := pretty.Compare(expected, instances); diff != "" {
+		t.Errorf("Unexpected discovered instances, diff: (-want +got)\n%s", diff)
+	}
+
+	server2, err := config.DiscoveredServerConfig(config.ServerConfig{SectionName: "discover_rds", DbUsername: "pganalyze_{tag:team}", DbName: "app"}, instances[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if server2.SectionName != "discover_rds/prod1" || server2.DbUsername != "pganalyze_search" || server2.SystemType != "amazon_rds" || server2.SystemID != "prod1" || server2.SystemScope != "us-east-1" {
+		t.Errorf("Unexpected discovered server config: %+v", server2)
+	}
+}
@@ -135,6 +135,7 @@ func SetupLogTails(ctx context.Context, servers []*state.Server, globalCollectio
 			if err != nil {
 				prefixedLogger.PrintError("ERROR - %s", err)
 			}
+			server.RecordLogTail(err)
 		} else if server.Config.LogDockerTail != "" {
 			if globalCollectionOpts.DebugLogs || globalCollectionOpts.TestRun {
 				prefixedLogger.PrintInfo("Setting up docker logs tail for %s", server.Config.LogDockerTail)
@@ -145,6 +146,7 @@ func SetupLogTails(ctx context.Context, servers []*state.Server, globalCollectio
 			if err != nil {
 				prefixedLogger.PrintError("ERROR - %s", err)
 			}
+			server.RecordLogTail(err)
 		}
 	}
 }
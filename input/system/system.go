@@ -24,6 +24,10 @@ func DownloadLogFiles(prevState state.PersistedLogState, config config.ServerCon
 // GetSystemState - Retrieves a system snapshot for this system and returns it
 func GetSystemState(config config.ServerConfig, logger *util.Logger) (system state.SystemState) {
 	dbHost := config.GetDbHost()
+
+	// Members of high availability clusters are remote hosts, even if db_host is not set
+	isLocal := (dbHost == "" || dbHost == "localhost" || dbHost == "127.0.0.1") && !config.HAEnabled()
+
 	if config.SystemType == "amazon_rds" {
 		system = rds.GetSystemState(config, logger)
 	} else if config.SystemType == "google_cloudsql" {
@@ -32,7 +36,7 @@ func GetSystemState(config config.ServerConfig, logger *util.Logger) (system sta
 		system.Info.Type = state.AzureDatabaseSystem
 	} else if config.SystemType == "heroku" {
 		system.Info.Type = state.HerokuSystem
-	} else if dbHost == "" || dbHost == "localhost" || dbHost == "127.0.0.1" || os.Getenv("PGA_ALWAYS_COLLECT_SYSTEM_DATA") != "" {
+	} else if isLocal || os.Getenv("PGA_ALWAYS_COLLECT_SYSTEM_DATA") != "" {
 		system = selfhosted.GetSystemState(config, logger)
 	}
 
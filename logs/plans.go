@@ -0,0 +1,208 @@
+package logs
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// Bounds on the in-memory plan history, to avoid unlimited growth with many distinct queries
+const maxTrackedQueries = 5000
+const maxPlansPerQuery = 10
+
+// ParseExplainPlan - Parses EXPLAIN (FORMAT JSON) output into its top-level plan node
+func ParseExplainPlan(explainOutput string) (state.PostgresPlanNode, error) {
+	var explain []struct {
+		Plan state.PostgresPlanNode `json:"Plan"`
+	}
+	err := json.Unmarshal([]byte(explainOutput), &explain)
+	if err != nil {
+		return state.PostgresPlanNode{}, err
+	}
+	if len(explain) != 1 {
+		return state.PostgresPlanNode{}, fmt.Errorf("expected one plan, got %d", len(explain))
+	}
+	return explain[0].Plan, nil
+}
+
+// PlanFingerprint - Generates a fingerprint of the plan shape, i.e. which nodes are used
+// on which relations and indexes, ignoring costs, row estimates and timing
+func PlanFingerprint(node state.PostgresPlanNode) string {
+	h := sha1.New()
+	writePlanShape(h, node)
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+func writePlanShape(w io.Writer, node state.PostgresPlanNode) {
+	fmt.Fprintf(w, "(%s|%s|%s|%s|%s|%s|%s|%s.%s|%s|%s|%s",
+		node.NodeType, node.Operation, node.Strategy, node.JoinType, node.ParentRelationship,
+		node.SubplanName, node.ScanDirection, node.Schema, node.RelationName, node.IndexName,
+		node.CTEName, node.FunctionName)
+	for _, child := range node.Plans {
+		writePlanShape(w, child)
+	}
+	io.WriteString(w, ")")
+}
+
+// PlanNodeSummary - Describes each node of the plan in one line (depth-first), e.g.
+// "Index Scan using users_pkey on public.users"
+func PlanNodeSummary(node state.PostgresPlanNode) (summary []string) {
+	description := node.NodeType
+	if node.JoinType != "" && node.JoinType != "Inner" {
+		description = node.NodeType + " (" + node.JoinType + ")"
+	}
+	if node.IndexName != "" {
+		description += " using " + node.IndexName
+	}
+	if node.RelationName != "" {
+		description += " on "
+		if node.Schema != "" {
+			description += node.Schema + "."
+		}
+		description += node.RelationName
+	}
+	summary = append(summary, description)
+
+	for _, child := range node.Plans {
+		summary = append(summary, PlanNodeSummary(child)...)
+	}
+	return
+}
+
+// TrackQueryPlans - Fingerprints the auto_explain plans in the log state, remembers which
+// plans were seen for each query, and flags plan changes on the query sample as well as
+// in the details of the log line
+func TrackQueryPlans(server *state.Server, logState state.TransientLogState, logger *util.Logger) {
+	logLineIdx := make(map[string][2]int)
+	for fileIdx, logFile := range logState.LogFiles {
+		for lineIdx, logLine := range logFile.LogLines {
+			logLineIdx[logLine.UUID.String()] = [2]int{fileIdx, lineIdx}
+		}
+	}
+
+	server.QueryPlansMutex.Lock()
+	defer server.QueryPlansMutex.Unlock()
+
+	if server.QueryPlans == nil {
+		server.QueryPlans = make(map[state.QueryPlanKey]*state.QueryPlanHistory)
+	}
+
+	for sampleIdx := range logState.QuerySamples {
+		sample := &logState.QuerySamples[sampleIdx]
+		if !sample.HasExplain || sample.ExplainSource != pganalyze_collector.QuerySample_AUTO_EXPLAIN_EXPLAIN_SOURCE ||
+			sample.ExplainFormat != pganalyze_collector.QuerySample_JSON_EXPLAIN_FORMAT {
+			continue
+		}
+
+		plan, err := ParseExplainPlan(sample.ExplainOutput)
+		if err != nil {
+			logger.PrintVerbose("Could not parse auto_explain plan: %s", err)
+			continue
+		}
+
+		key := state.QueryPlanKey{Database: sample.Database, QueryFingerprint: util.FingerprintQuery(sample.Query)}
+		history, exists := server.QueryPlans[key]
+		if !exists {
+			history = &state.QueryPlanHistory{}
+			server.QueryPlans[key] = history
+		}
+		planFingerprint := PlanFingerprint(plan)
+		previousPlanFingerprint := history.LastPlanFingerprint
+		stats := recordPlan(history, planFingerprint, plan, *sample)
+		planChanged := previousPlanFingerprint != "" && previousPlanFingerprint != planFingerprint
+
+		sample.PlanFingerprint = planFingerprint
+		sample.PlanNodes = stats.NodeSummary
+		if planChanged {
+			sample.PlanChanged = true
+			sample.PreviousPlanFingerprint = previousPlanFingerprint
+			logger.PrintVerbose("Plan changed for query in database %s (%s -> %s): %s", sample.Database, previousPlanFingerprint, planFingerprint, sample.Query)
+		}
+
+		idx, ok := logLineIdx[sample.LogLineUUID.String()]
+		if !ok {
+			continue
+		}
+		logLine := &logState.LogFiles[idx[0]].LogLines[idx[1]]
+		if logLine.Details == nil {
+			logLine.Details = make(map[string]interface{})
+		}
+		logLine.Details["plan_fingerprint"] = planFingerprint
+		logLine.Details["plan_nodes"] = stats.NodeSummary
+		logLine.Details["plan_seen_count"] = stats.Count
+		logLine.Details["plan_avg_runtime_ms"] = stats.TotalRuntimeMs / float64(stats.Count)
+
+		if planChanged {
+			logLine.Details["plan_changed"] = true
+			logLine.Details["previous_plan_fingerprint"] = previousPlanFingerprint
+			for _, previous := range history.Plans {
+				if previous.PlanFingerprint == previousPlanFingerprint {
+					logLine.Details["previous_plan_avg_runtime_ms"] = previous.TotalRuntimeMs / float64(previous.Count)
+				}
+			}
+		}
+	}
+
+	expireQueryPlans(server.QueryPlans)
+}
+
+func recordPlan(history *state.QueryPlanHistory, planFingerprint string, plan state.PostgresPlanNode, sample state.PostgresQuerySample) state.QueryPlanStats {
+	history.LastPlanFingerprint = planFingerprint
+	history.LastSeenAt = sample.OccurredAt
+
+	for idx := range history.Plans {
+		stats := &history.Plans[idx]
+		if stats.PlanFingerprint == planFingerprint {
+			stats.LastSeenAt = sample.OccurredAt
+			stats.Count++
+			stats.TotalRuntimeMs += sample.RuntimeMs
+			if sample.RuntimeMs > stats.MaxRuntimeMs {
+				stats.MaxRuntimeMs = sample.RuntimeMs
+			}
+			return *stats
+		}
+	}
+
+	stats := state.QueryPlanStats{
+		PlanFingerprint: planFingerprint,
+		NodeSummary:     PlanNodeSummary(plan),
+		FirstSeenAt:     sample.OccurredAt,
+		LastSeenAt:      sample.OccurredAt,
+		Count:           1,
+		TotalRuntimeMs:  sample.RuntimeMs,
+		MaxRuntimeMs:    sample.RuntimeMs,
+	}
+	history.Plans = append(history.Plans, stats)
+	if len(history.Plans) > maxPlansPerQuery {
+		sort.Slice(history.Plans, func(i, j int) bool {
+			return history.Plans[i].LastSeenAt.After(history.Plans[j].LastSeenAt)
+		})
+		history.Plans = history.Plans[:maxPlansPerQuery]
+	}
+	return stats
+}
+
+// expireQueryPlans - Forgets the queries that were least recently seen, once there are too many
+func expireQueryPlans(queryPlans map[state.QueryPlanKey]*state.QueryPlanHistory) {
+	if len(queryPlans) <= maxTrackedQueries {
+		return
+	}
+
+	var keys []state.QueryPlanKey
+	for key := range queryPlans {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return queryPlans[keys[i]].LastSeenAt.Before(queryPlans[keys[j]].LastSeenAt)
+	})
+	for _, key := range keys[:len(keys)-maxTrackedQueries] {
+		delete(queryPlans, key)
+	}
+}
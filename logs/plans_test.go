@@ -0,0 +1,118 @@
+package logs_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pganalyze/collector/logs"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+const indexScanPlan = `2018-03-11 20:00:02 UTC:1.1.1.1(2):a@b:[3]:LOG:  duration: 2.000 ms  plan:
+	{
+	  "Query Text": "SELECT * FROM users WHERE id = 1",
+	  "Plan": {
+	    "Node Type": "Index Scan",
+	    "Scan Direction": "Forward",
+	    "Index Name": "users_pkey",
+	    "Relation Name": "users",
+	    "Schema": "public",
+	    "Startup Cost": 0.29,
+	    "Total Cost": 8.30,
+	    "Plan Rows": 1
+	  }
+	}
+`
+
+const indexScanPlanNewEstimates = `2018-03-11 20:01:02 UTC:1.1.1.1(2):a@b:[3]:LOG:  duration: 4.000 ms  plan:
+	{
+	  "Query Text": "SELECT * FROM users WHERE id = 2",
+	  "Plan": {
+	    "Node Type": "Index Scan",
+	    "Scan Direction": "Forward",
+	    "Index Name": "users_pkey",
+	    "Relation Name": "users",
+	    "Schema": "public",
+	    "Startup Cost": 0.42,
+	    "Total Cost": 12.50,
+	    "Plan Rows": 3
+	  }
+	}
+`
+
+const seqScanPlan = `2018-03-11 20:02:02 UTC:1.1.1.1(2):a@b:[3]:LOG:  duration: 1500.000 ms  plan:
+	{
+	  "Query Text": "SELECT * FROM users WHERE id = 3",
+	  "Plan": {
+	    "Node Type": "Seq Scan",
+	    "Relation Name": "users",
+	    "Schema": "public",
+	    "Total Cost": 1693.00,
+	    "Plan Rows": 1
+	  }
+	}
+`
+
+func trackPlan(server *state.Server, input string) (map[string]interface{}, state.PostgresQuerySample) {
+	logLines, samples, _ := logs.ParseAndAnalyzeBuffer(input, 0, time.Time{})
+	logState := state.TransientLogState{
+		LogFiles:     []state.LogFile{{LogLines: logLines}},
+		QuerySamples: samples,
+	}
+	logs.TrackQueryPlans(server, logState, &util.Logger{})
+	return logState.LogFiles[0].LogLines[0].Details, logState.QuerySamples[0]
+}
+
+func TestTrackQueryPlans(t *testing.T) {
+	server := &state.Server{QueryPlansMutex: &sync.Mutex{}}
+	cfg := pretty.CompareConfig
+
+	first, firstSample := trackPlan(server, indexScanPlan)
+	if _, ok := first["plan_changed"]; ok {
+		t.Errorf("Expected first plan to not be flagged as changed, got details: %v", first)
+	}
+	if diff := cfg.Compare([]string{"Index Scan using users_pkey on public.users"}, first["plan_nodes"]); diff != "" {
+		t.Errorf("Unexpected plan node summary, diff: (-want +got)\n%s", diff)
+	}
+
+	// Different costs and row estimates result in the same plan fingerprint
+	second, _ := trackPlan(server, indexScanPlanNewEstimates)
+	if second["plan_fingerprint"] != first["plan_fingerprint"] {
+		t.Errorf("Expected same plan fingerprint, got %v and %v", first["plan_fingerprint"], second["plan_fingerprint"])
+	}
+	if _, ok := second["plan_changed"]; ok {
+		t.Errorf("Expected plan with new estimates to not be flagged as changed, got details: %v", second)
+	}
+	if second["plan_seen_count"] != int64(2) || second["plan_avg_runtime_ms"] != 3.0 {
+		t.Errorf("Expected plan to be seen twice with 3ms average runtime, got details: %v", second)
+	}
+
+	third, thirdSample := trackPlan(server, seqScanPlan)
+	expected := map[string]interface{}{
+		"duration_ms":                  1500.0,
+		"plan_fingerprint":             third["plan_fingerprint"],
+		"plan_nodes":                   []string{"Seq Scan on public.users"},
+		"plan_seen_count":              int64(1),
+		"plan_avg_runtime_ms":          1500.0,
+		"plan_changed":                 true,
+		"previous_plan_fingerprint":    first["plan_fingerprint"],
+		"previous_plan_avg_runtime_ms": 3.0,
+	}
+	if diff := cfg.Compare(expected, third); diff != "" {
+		t.Errorf("Unexpected details for changed plan, diff: (-want +got)\n%s", diff)
+	}
+
+	// The plan information is also sent with the query sample itself
+	if firstSample.PlanFingerprint != first["plan_fingerprint"] || firstSample.PlanChanged {
+		t.Errorf("Unexpected plan information on first sample: %+v", firstSample)
+	}
+	if !thirdSample.PlanChanged || thirdSample.PlanFingerprint != third["plan_fingerprint"] || thirdSample.PreviousPlanFingerprint != first["plan_fingerprint"] {
+		t.Errorf("Expected changed plan to be flagged on the query sample, got: %+v", thirdSample)
+	}
+	if diff := cfg.Compare([]string{"Seq Scan on public.users"}, thirdSample.PlanNodes); diff != "" {
+		t.Errorf("Unexpected plan nodes on query sample, diff: (-want +got)\n%s", diff)
+	}
+}
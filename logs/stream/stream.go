@@ -280,6 +280,7 @@ func ProcessLogStream(server *state.Server, logLines []state.LogLine, globalColl
 	}
 
 	logState.LogFiles = []state.LogFile{logFile}
+	logs.TrackQueryPlans(server, logState, prefixedLogger)
 
 	if globalCollectionOpts.DebugLogs {
 		prefixedLogger.PrintInfo("Would have sent log state:\n")
@@ -298,6 +299,7 @@ func ProcessLogStream(server *state.Server, logLines []state.LogLine, globalColl
 	grant, err := grant.GetLogsGrant(server, globalCollectionOpts, prefixedLogger)
 	if err != nil {
 		prefixedLogger.PrintError("Could not get log grant: %s", err)
+		server.RecordRun("logs", err)
 		logState.Cleanup()
 		return logLines // Retry
 	}
@@ -311,10 +313,12 @@ func ProcessLogStream(server *state.Server, logLines []state.LogLine, globalColl
 	err = output.UploadAndSendLogs(server, grant, globalCollectionOpts, prefixedLogger, logState)
 	if err != nil {
 		prefixedLogger.PrintError("Failed to upload/send logs: %s", err)
+		server.RecordRun("logs", err)
 		logState.Cleanup()
 		return logLines // Retry
 	}
 
+	server.RecordRun("logs", nil)
 	logState.Cleanup()
 	return tooFreshLogLines
 }
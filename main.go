@@ -36,9 +36,7 @@ import (
 
 const streamBufferLen = 500
 
-func run(ctx context.Context, wg *sync.WaitGroup, globalCollectionOpts state.CollectionOpts, logger *util.Logger, configFilename string) (keepRunning bool, reloadOkay bool) {
-	var servers []*state.Server
-
+func run(ctx context.Context, wg *sync.WaitGroup, globalCollectionOpts state.CollectionOpts, logger *util.Logger, configFilename string, collector *collectorServers) (keepRunning bool, reloadOkay bool) {
 	keepRunning = false
 	reloadOkay = false
 
@@ -55,6 +53,15 @@ func run(ctx context.Context, wg *sync.WaitGroup, globalCollectionOpts state.Col
 		return
 	}
 
+	// Held until all servers and their log processing are set up
+	collector.mutex.Lock()
+	defer collector.mutex.Unlock()
+
+	configuredServers := conf.Servers
+	discoveredServers, _ := runner.DiscoverServers(conf, collector.discovered, logger)
+	collector.discovered = discoveredServers
+	conf.Servers = append(append([]config.ServerConfig{}, configuredServers...), discoveredServers...)
+
 	for idx, server := range conf.Servers {
 		prefixedLogger := logger.WithPrefix(server.SectionName)
 		prefixedLogger.PrintVerbose("Identified as api_system_type: %s, api_system_scope: %s, api_system_id: %s", server.SystemType, server.SystemScope, server.SystemID)
@@ -65,17 +72,30 @@ func run(ctx context.Context, wg *sync.WaitGroup, globalCollectionOpts state.Col
 	// Avoid even running the scheduler when we already know its not needed
 	hasAnyLogsEnabled := false
 	hasAnyReportsEnabled := false
+	hasAnyLocalReports := false
 	hasAnyActivityEnabled := false
 	hasAnyGoogleCloudSQL := false
 	hasAnyAzureDatabase := false
 	hasAnyHeroku := false
 
-	serverConfigs := conf.Servers
+	// Servers whose configuration did not change since the last reload are kept as they
+	// are, including their state and log tails
+	servers, newServers := collector.update(conf.Servers, logger)
+	runner.ConfigureConcurrency(conf, logger)
+
+	// Discovered servers can be added later on without a reload, and inherit their settings
+	// from the discovery section, so make sure the collection they need is running
+	serverConfigs := append([]config.ServerConfig{}, conf.Discovery...)
+	for _, server := range servers {
+		serverConfigs = append(serverConfigs, server.Config)
+	}
 	for _, config := range serverConfigs {
-		servers = append(servers, &state.Server{Config: config, StateMutex: &sync.Mutex{}, LogStateMutex: &sync.Mutex{}, ActivityStateMutex: &sync.Mutex{}, CollectionStatusMutex: &sync.Mutex{}})
 		if config.EnableReports {
 			hasAnyReportsEnabled = true
 		}
+		if config.LocalReports != "" {
+			hasAnyLocalReports = true
+		}
 		if !config.DisableLogs {
 			hasAnyLogsEnabled = true
 		}
@@ -93,7 +113,7 @@ func run(ctx context.Context, wg *sync.WaitGroup, globalCollectionOpts state.Col
 		}
 	}
 
-	state.ReadStateFile(servers, globalCollectionOpts, logger)
+	state.ReadStateFile(newServers, globalCollectionOpts, logger)
 
 	// We intentionally don't do a test-run in the normal mode, since we're fine with
 	// a later SIGHUP that fixes the config (or a temporarily unreachable server at start)
@@ -102,6 +122,18 @@ func run(ctx context.Context, wg *sync.WaitGroup, globalCollectionOpts state.Col
 		if globalCollectionOpts.TestReport != "" {
 			runner.RunTestReport(servers, globalCollectionOpts, logger)
 			return
+		} else if globalCollectionOpts.LocalReport != "" {
+			runner.RunLocalReport(servers, globalCollectionOpts, logger)
+			return
+		} else if globalCollectionOpts.SetupMonitoringUser {
+			runner.SetupMonitoringUser(servers, globalCollectionOpts, logger)
+			return
+		} else if globalCollectionOpts.CheckPermissions {
+			runner.CheckPermissions(servers, globalCollectionOpts, logger)
+			return
+		} else if globalCollectionOpts.CompareSettings != "" {
+			runner.CompareSettings(servers, globalCollectionOpts, logger)
+			return
 		} else if globalCollectionOpts.TestRunLogs {
 			runner.TestLogsForAllServers(servers, globalCollectionOpts, logger)
 			return
@@ -195,79 +227,109 @@ func run(ctx context.Context, wg *sync.WaitGroup, globalCollectionOpts state.Col
 		return
 	}
 
+	if conf.StatusListenAddress != "" {
+		runner.SetupStatusServer(ctx, wg, conf.StatusListenAddress, collector.current, globalCollectionOpts, logger)
+	}
+
+	if len(conf.Discovery) > 0 {
+		schedulerGroups["discovery"].Schedule(ctx, func() {
+			wg.Add(1)
+			defer wg.Done()
+			// Sections whose discovery failed keep their previously discovered servers
+			current, _ := runner.DiscoverServers(conf, discoveredServers, logger)
+			if !runner.DiscoveredServersChanged(discoveredServers, current, logger) {
+				return
+			}
+			discoveredServers = current
+			collector.applyDiscoveredServers(ctx, configuredServers, discoveredServers, globalCollectionOpts, logger)
+		}, logger, "discovery of servers")
+	}
+
 	schedulerGroups["stats"].Schedule(ctx, func() {
 		wg.Add(1)
-		runner.CollectAllServers(servers, globalCollectionOpts, logger)
+		runner.CollectAllServers(collector.current(), globalCollectionOpts, logger)
 		wg.Done()
 	}, logger, "full snapshot of all servers")
 
 	if hasAnyReportsEnabled {
 		schedulerGroups["reports"].Schedule(ctx, func() {
 			wg.Add(1)
-			runner.RunRequestedReports(servers, globalCollectionOpts, logger)
+			runner.RunRequestedReports(collector.current(), globalCollectionOpts, logger)
 			wg.Done()
 		}, logger, "requested reports for all servers")
 	}
 
+	if hasAnyLocalReports {
+		schedulerGroups["local_reports"].Schedule(ctx, func() {
+			wg.Add(1)
+			runner.WriteLocalReports(collector.current(), globalCollectionOpts, logger)
+			wg.Done()
+		}, logger, "local reports for all servers")
+	}
+
 	if hasAnyLogsEnabled {
 		var hasAnyLogDownloads bool
-		var hasAnyLogTails bool
 
 		for _, server := range servers {
 			if server.Config.DisableLogs {
 				continue
 			}
-			if server.Config.LogLocation != "" || server.Config.LogDockerTail != "" {
-				hasAnyLogTails = true
-			} else if server.Config.AwsDbInstanceID != "" {
+			if server.Config.LogLocation == "" && server.Config.LogDockerTail == "" && server.Config.AwsDbInstanceID != "" {
 				hasAnyLogDownloads = true
 			}
 		}
-
-		if hasAnyLogTails {
-			selfhosted.SetupLogTails(ctx, servers, globalCollectionOpts, logger)
+		for _, discovery := range conf.Discovery {
+			// Discovered RDS instances download their logs, like any other RDS instance
+			if !discovery.DisableLogs && discovery.LogLocation == "" && discovery.LogDockerTail == "" && !config.IsKubernetesDiscoverySection(discovery.SectionName) {
+				hasAnyLogDownloads = true
+			}
 		}
 
+		collector.setupLogTails(newServers, globalCollectionOpts, logger)
+
+		// The HTTP handlers can only be registered once, and keep running across reloads
 		if hasAnyHeroku && os.Getenv("DYNO") != "" && os.Getenv("PORT") != "" {
-			herokuLogStream := make(chan heroku.HerokuLogStreamItem, streamBufferLen)
-			heroku.SetupHttpHandlerLogs(herokuLogStream)
-			heroku.SetupLogReceiver(servers, globalCollectionOpts, logger, herokuLogStream)
+			if !collector.herokuStarted {
+				collector.herokuStarted = true
+				herokuLogStream := make(chan heroku.HerokuLogStreamItem, streamBufferLen)
+				heroku.SetupHttpHandlerLogs(herokuLogStream)
+				heroku.SetupLogReceiver(collector.current, globalCollectionOpts, logger, herokuLogStream)
+			}
+			heroku.IdentifyServers(newServers, globalCollectionOpts, logger)
 		}
 
-		if hasAnyGoogleCloudSQL {
-			gcpLogStream := make(chan google_cloudsql.LogStreamItem, streamBufferLen)
-			google_cloudsql.SetupLogSubscriber(ctx, wg, globalCollectionOpts, logger, servers, gcpLogStream)
-			google_cloudsql.SetupLogReceiver(ctx, servers, globalCollectionOpts, logger, gcpLogStream)
-		}
-		if hasAnyAzureDatabase {
-			azureLogStream := make(chan azure.AzurePostgresLogRecord, streamBufferLen)
-			azure.SetupLogSubscriber(ctx, wg, globalCollectionOpts, logger, servers, azureLogStream)
-			azure.SetupLogReceiver(ctx, servers, globalCollectionOpts, logger, azureLogStream)
-		}
+		collector.setupGoogleCloudSQLLogs(hasAnyGoogleCloudSQL, globalCollectionOpts, logger)
+		collector.setupAzureLogs(hasAnyAzureDatabase, globalCollectionOpts, logger)
 
 		if hasAnyLogDownloads {
 			schedulerGroups["logs"].Schedule(ctx, func() {
 				wg.Add(1)
-				runner.DownloadLogsFromAllServers(servers, globalCollectionOpts, logger)
+				runner.DownloadLogsFromAllServers(collector.current(), globalCollectionOpts, logger)
 				wg.Done()
 			}, logger, "log snapshot of all servers")
 		}
-	} else if os.Getenv("DYNO") != "" && os.Getenv("PORT") != "" {
-		// Even if logs are deactivated, Heroku still requires us to have a functioning web server
-		heroku.SetupHttpHandlerDummy()
+	} else {
+		collector.setupGoogleCloudSQLLogs(false, globalCollectionOpts, logger)
+		collector.setupAzureLogs(false, globalCollectionOpts, logger)
+
+		if os.Getenv("DYNO") != "" && os.Getenv("PORT") != "" && !collector.herokuStarted {
+			// Even if logs are deactivated, Heroku still requires us to have a functioning web server
+			collector.herokuStarted = true
+			heroku.SetupHttpHandlerDummy()
+		}
 	}
 
 	if hasAnyActivityEnabled {
 		schedulerGroups["activity"].Schedule(ctx, func() {
 			wg.Add(1)
-			runner.CollectActivityFromAllServers(servers, globalCollectionOpts, logger)
+			runner.CollectActivityFromAllServers(collector.current(), globalCollectionOpts, logger)
 			wg.Done()
 		}, logger, "activity snapshot of all servers")
 	}
 
 	schedulerGroups["query_stats"].ScheduleSecondary(ctx, func() {
 		wg.Add(1)
-		runner.GatherQueryStatsFromAllServers(servers, globalCollectionOpts, logger)
+		runner.GatherQueryStatsFromAllServers(collector.current(), globalCollectionOpts, logger)
 		wg.Done()
 	}, logger, "high frequency query statistics of all servers", schedulerGroups["stats"])
 
@@ -289,7 +351,15 @@ func main() {
 	var discoverLogLocation bool
 	var testRun bool
 	var testReport string
+	var localReport string
+	var localReportSection string
+	var localReportFormat string
+	var compareSettings string
 	var testRunLogs bool
+	var setupMonitoringUser bool
+	var checkPermissions bool
+	var superuserUsername string
+	var superuserPassword string
 	var forceStateUpdate bool
 	var configFilename string
 	var stateFilename string
@@ -300,7 +370,10 @@ func main() {
 	var testRunAndTrace bool
 	var logToSyslog bool
 	var logNoTimestamps bool
+	var logFormat string
+	var logLevel string
 	var reloadRun bool
+	var validateConfig bool
 
 	logFlags := log.LstdFlags
 	logger := &util.Logger{}
@@ -308,11 +381,21 @@ func main() {
 	flag.BoolVarP(&showVersion, "version", "", false, "Shows current version of the collector and exits")
 	flag.BoolVarP(&testRun, "test", "t", false, "Tests whether we can successfully collect statistics (including log data if configured), submits it to the server, and exits afterwards")
 	flag.StringVar(&testReport, "test-report", "", "Tests a particular report and returns its output as JSON")
+	flag.StringVar(&localReportSection, "section", "", "Config section to run the report for, used with the \"report\" command (default is all configured servers)")
+	flag.StringVar(&localReportFormat, "format", "table", "Output format of the \"report\" command: table, json or csv")
+	flag.StringVar(&compareSettings, "compare-settings", "", "Compares the Postgres configuration settings of two servers, specified as two config section names separated by a comma (e.g. --compare-settings=primary,replica)")
+	flag.BoolVar(&setupMonitoringUser, "setup-monitoring-user", false, "Creates (or upgrades) the monitoring user and the pganalyze helper functions in all monitored databases, connecting as the superuser given with --superuser-username")
+	flag.BoolVar(&checkPermissions, "check-permissions", false, "Checks which pganalyze helper functions are missing in the monitored databases, and which data collection is degraded as a result")
+	flag.StringVar(&superuserUsername, "superuser-username", "postgres", "Superuser to connect as for --setup-monitoring-user")
+	flag.StringVar(&superuserPassword, "superuser-password", "", "Password of the superuser for --setup-monitoring-user (defaults to the PGPASSWORD environment variable)")
 	flag.BoolVar(&testRunLogs, "test-logs", false, "Tests whether log collection works (does not test privilege dropping for local log collection, use --test for that)")
+	flag.BoolVar(&validateConfig, "validate-config", false, "Checks the config file for unknown settings, invalid values and conflicting options, and exits with a non-zero status if it has errors")
 	flag.BoolVar(&reloadRun, "reload", false, "Reloads the collector daemon thats running on the host")
 	flag.BoolVarP(&logger.Verbose, "verbose", "v", false, "Outputs additional debugging information, use this if you're encoutering errors or other problems")
 	flag.BoolVar(&logToSyslog, "syslog", false, "Write all log output to syslog instead of stderr (disabled by default)")
 	flag.BoolVar(&logNoTimestamps, "no-log-timestamps", false, "Disable timestamps in the log output (automatically done when syslog is enabled)")
+	flag.StringVar(&logFormat, "log-format", "text", "Format of the collector's log output: text, or json for one JSON object per message")
+	flag.StringVar(&logLevel, "log-level", "", "Only output log messages of this level or higher: verbose (same as --verbose), info, warning or error (default info)")
 	flag.BoolVar(&dryRun, "dry-run", false, "Print JSON data that would get sent to web service (without actually sending) and exit afterwards")
 	flag.BoolVar(&dryRunLogs, "dry-run-logs", false, "Print JSON data for log snapshot (without actually sending) and exit afterwards")
 	flag.StringVar(&analyzeLogfile, "analyze-logfile", "", "Analyzes the content of the given log file and returns debug output about it")
@@ -342,7 +425,27 @@ func main() {
 		return
 	}
 
-	if logNoTimestamps || logToSyslog {
+	if logFormat == "json" {
+		logger.JSON = true
+	} else if logFormat != "text" {
+		fmt.Fprintf(os.Stderr, "Unsupported log format \"%s\" (supported: text, json)\n", logFormat)
+		os.Exit(1)
+	}
+
+	if logLevel != "" {
+		var err error
+		logger.MinLevel, err = util.ParseLogLevel(logLevel)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+			os.Exit(1)
+		}
+		if logger.MinLevel == util.LogLevelVerbose {
+			logger.Verbose = true
+		}
+	}
+
+	// JSON log messages include their own timestamp
+	if logNoTimestamps || logToSyslog || logger.JSON {
 		logFlags = 0
 	}
 
@@ -367,7 +470,40 @@ func main() {
 		}
 	}
 
-	if testReport != "" || testRunLogs || testRunAndTrace {
+	// "pganalyze-collector report <type>" runs a report locally and prints it in a human-readable format
+	if flag.Arg(0) == "report" {
+		localReport = flag.Arg(1)
+		if localReport == "" {
+			fmt.Fprintf(os.Stderr, "Usage: %s report <type> [--section <config section>] [--format table|json|csv]\n", os.Args[0])
+			os.Exit(1)
+		}
+	}
+
+	if validateConfig {
+		problems, err := config.Validate(configFilename)
+		if err != nil {
+			fmt.Printf("ERROR Could not parse %s: %s\n", configFilename, err)
+			os.Exit(1)
+		}
+		hasErrors := false
+		for _, problem := range problems {
+			fmt.Printf("%s\n", problem)
+			if !problem.Warning {
+				hasErrors = true
+			}
+		}
+		if hasErrors {
+			os.Exit(1)
+		}
+		fmt.Printf("Configuration file %s is valid\n", configFilename)
+		return
+	}
+
+	if superuserPassword == "" {
+		superuserPassword = os.Getenv("PGPASSWORD")
+	}
+
+	if testReport != "" || localReport != "" || compareSettings != "" || setupMonitoringUser || checkPermissions || testRunLogs || testRunAndTrace {
 		testRun = true
 	}
 
@@ -376,6 +512,14 @@ func main() {
 		SubmitCollectedData:      true,
 		TestRun:                  testRun,
 		TestReport:               testReport,
+		LocalReport:              localReport,
+		LocalReportSection:       localReportSection,
+		LocalReportFormat:        localReportFormat,
+		CompareSettings:          compareSettings,
+		SetupMonitoringUser:      setupMonitoringUser,
+		CheckPermissions:         checkPermissions,
+		SuperuserUsername:        superuserUsername,
+		SuperuserPassword:        superuserPassword,
 		TestRunLogs:              testRunLogs || dryRunLogs,
 		DebugLogs:                debugLogs,
 		DiscoverLogLocation:      discoverLogLocation,
@@ -460,10 +604,12 @@ func main() {
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP)
 
+	collector := &collectorServers{}
+
 ReadConfigAndRun:
 	ctx, cancel := context.WithCancel(context.Background())
 	wg := sync.WaitGroup{}
-	keepRunning, reloadOkay := run(ctx, &wg, globalCollectionOpts, logger, configFilename)
+	keepRunning, reloadOkay := run(ctx, &wg, globalCollectionOpts, logger, configFilename, collector)
 
 	if keepRunning {
 		// Block here until we get any of the registered signals
@@ -495,6 +641,7 @@ ReadConfigAndRun:
 
 	cancel()
 	wg.Wait()
+	collector.stop()
 
 	if reloadRun {
 		if reloadOkay {
@@ -11,7 +11,13 @@ import (
 func SubmitCompactActivitySnapshot(server *state.Server, grant state.Grant, collectionOpts state.CollectionOpts, logger *util.Logger, activityState state.TransientActivityState) error {
 	as, r := transform.ActivityStateToCompactActivitySnapshot(server, activityState)
 
-	if server.Config.FilterQuerySample == "all" {
+	if server.Config.FilterQueryText == util.FilterQueryTextNormalize {
+		for idx, backend := range as.Backends {
+			if backend.QueryText != "" {
+				as.Backends[idx].QueryText = util.NormalizeQuery(backend.QueryText, server.Config.FilterQueryText)
+			}
+		}
+	} else if server.Config.FilterQuerySample == "all" {
 		for idx, backend := range as.Backends {
 			if backend.QueryText != "" {
 				as.Backends[idx].QueryText, _ = pg_query.Normalize(backend.QueryText)
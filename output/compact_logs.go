@@ -9,12 +9,19 @@ import (
 
 // UploadAndSendLogs - Filters the log file, then uploads it to the storage and sends the metadata to the API
 func UploadAndSendLogs(server *state.Server, grant state.GrantLogs, collectionOpts state.CollectionOpts, logger *util.Logger, logState state.TransientLogState) error {
+	filterLogSecret := state.ParseFilterLogSecret(server.Config.FilterLogSecret)
+	if server.Config.FilterQueryText == util.FilterQueryTextNormalize {
+		// Statement texts and parameters in the log contain literal values
+		filterLogSecret = append(filterLogSecret, state.StatementTextLogSecret, state.StatementParameterLogSecret)
+	}
 	for idx := range logState.LogFiles {
-		logState.LogFiles[idx].FilterLogSecret = state.ParseFilterLogSecret(server.Config.FilterLogSecret)
+		logState.LogFiles[idx].FilterLogSecret = filterLogSecret
 	}
 
 	if server.Config.FilterQuerySample == "all" {
 		logState.QuerySamples = []state.PostgresQuerySample{}
+	} else if server.Config.FilterQueryText == util.FilterQueryTextNormalize {
+		logState.QuerySamples = normalizeQuerySamples(logState.QuerySamples)
 	}
 
 	if collectionOpts.SubmitCollectedData && grant.EncryptionKey.CiphertextBlob != "" {
@@ -31,3 +38,15 @@ func UploadAndSendLogs(server *state.Server, grant state.GrantLogs, collectionOp
 
 	return uploadAndSubmitCompactSnapshot(s, snapshotGrant, server, collectionOpts, logger, logState.CollectedAt, false, "logs")
 }
+
+// normalizeQuerySamples - Replaces literals in the query samples with $n placeholders,
+// and removes parameters as well as EXPLAIN output (which contains literal values)
+func normalizeQuerySamples(samples []state.PostgresQuerySample) []state.PostgresQuerySample {
+	for idx, sample := range samples {
+		samples[idx].Query = util.NormalizeQuery(sample.Query, util.FilterQueryTextNormalize)
+		samples[idx].Parameters = nil
+		samples[idx].HasExplain = false
+		samples[idx].ExplainOutput = ""
+	}
+	return samples
+}
@@ -873,6 +873,64 @@ func (VacuumProgressStatistic_VacuumPhase) EnumDescriptor() ([]byte, []int) {
 	return file_compact_activity_snapshot_proto_rawDescGZIP(), []int{3, 0}
 }
 
+type ProgressInformation_Command int32
+
+const (
+	ProgressInformation_UNKNOWN      ProgressInformation_Command = 0
+	ProgressInformation_CREATE_INDEX ProgressInformation_Command = 1
+	ProgressInformation_ANALYZE      ProgressInformation_Command = 2
+	ProgressInformation_CLUSTER      ProgressInformation_Command = 3
+	ProgressInformation_COPY         ProgressInformation_Command = 4
+	ProgressInformation_BASE_BACKUP  ProgressInformation_Command = 5
+)
+
+// Enum value maps for ProgressInformation_Command.
+var (
+	ProgressInformation_Command_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "CREATE_INDEX",
+		2: "ANALYZE",
+		3: "CLUSTER",
+		4: "COPY",
+		5: "BASE_BACKUP",
+	}
+	ProgressInformation_Command_value = map[string]int32{
+		"UNKNOWN":      0,
+		"CREATE_INDEX": 1,
+		"ANALYZE":      2,
+		"CLUSTER":      3,
+		"COPY":         4,
+		"BASE_BACKUP":  5,
+	}
+)
+
+func (x ProgressInformation_Command) Enum() *ProgressInformation_Command {
+	p := new(ProgressInformation_Command)
+	*p = x
+	return p
+}
+
+func (x ProgressInformation_Command) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProgressInformation_Command) Descriptor() protoreflect.EnumDescriptor {
+	return file_compact_activity_snapshot_proto_enumTypes[3].Descriptor()
+}
+
+func (ProgressInformation_Command) Type() protoreflect.EnumType {
+	return &file_compact_activity_snapshot_proto_enumTypes[3]
+}
+
+func (x ProgressInformation_Command) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProgressInformation_Command.Descriptor instead.
+func (ProgressInformation_Command) EnumDescriptor() ([]byte, []int) {
+	return file_compact_activity_snapshot_proto_rawDescGZIP(), []int{4, 0}
+}
+
 type CompactActivitySnapshot struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -885,6 +943,8 @@ type CompactActivitySnapshot struct {
 	PrevActivitySnapshotAt     *timestamp.Timestamp         `protobuf:"bytes,3,opt,name=prev_activity_snapshot_at,json=prevActivitySnapshotAt,proto3" json:"prev_activity_snapshot_at,omitempty"`
 	VacuumProgressInformations []*VacuumProgressInformation `protobuf:"bytes,10,rep,name=vacuum_progress_informations,json=vacuumProgressInformations,proto3" json:"vacuum_progress_informations,omitempty"`
 	VacuumProgressStatistics   []*VacuumProgressStatistic   `protobuf:"bytes,11,rep,name=vacuum_progress_statistics,json=vacuumProgressStatistics,proto3" json:"vacuum_progress_statistics,omitempty"`
+	ProgressInformations       []*ProgressInformation       `protobuf:"bytes,12,rep,name=progress_informations,json=progressInformations,proto3" json:"progress_informations,omitempty"`
+	ProgressStatistics         []*ProgressStatistic         `protobuf:"bytes,13,rep,name=progress_statistics,json=progressStatistics,proto3" json:"progress_statistics,omitempty"`
 }
 
 func (x *CompactActivitySnapshot) Reset() {
@@ -954,6 +1014,20 @@ func (x *CompactActivitySnapshot) GetVacuumProgressStatistics() []*VacuumProgres
 	return nil
 }
 
+func (x *CompactActivitySnapshot) GetProgressInformations() []*ProgressInformation {
+	if x != nil {
+		return x.ProgressInformations
+	}
+	return nil
+}
+
+func (x *CompactActivitySnapshot) GetProgressStatistics() []*ProgressStatistic {
+	if x != nil {
+		return x.ProgressStatistics
+	}
+	return nil
+}
+
 type Backend struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1367,6 +1441,247 @@ func (x *VacuumProgressStatistic) GetNumDeadTuples() int64 {
 	return 0
 }
 
+// Long-running operation other than VACUUM that reports its progress (pg_stat_progress_*)
+type ProgressInformation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProgressIdentity uint64                      `protobuf:"varint,1,opt,name=progress_identity,json=progressIdentity,proto3" json:"progress_identity,omitempty"` // Server-wide unique identifier for this operation (query_start + PID)
+	RoleIdx          int32                       `protobuf:"varint,2,opt,name=role_idx,json=roleIdx,proto3" json:"role_idx,omitempty"`
+	DatabaseIdx      int32                       `protobuf:"varint,3,opt,name=database_idx,json=databaseIdx,proto3" json:"database_idx,omitempty"`
+	RelationIdx      int32                       `protobuf:"varint,4,opt,name=relation_idx,json=relationIdx,proto3" json:"relation_idx,omitempty"`
+	BackendIdentity  uint64                      `protobuf:"varint,5,opt,name=backend_identity,json=backendIdentity,proto3" json:"backend_identity,omitempty"`
+	StartedAt        *timestamp.Timestamp        `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	Command          ProgressInformation_Command `protobuf:"varint,7,opt,name=command,proto3,enum=pganalyze.collector.ProgressInformation_Command" json:"command,omitempty"`
+	CommandDetail    string                      `protobuf:"bytes,8,opt,name=command_detail,json=commandDetail,proto3" json:"command_detail,omitempty"` // Sub-command as reported by Postgres, e.g. "CREATE INDEX CONCURRENTLY" or "COPY FROM"
+	IndexName        string                      `protobuf:"bytes,9,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`             // CREATE INDEX: Index being built, CLUSTER: Index used for ordering
+}
+
+func (x *ProgressInformation) Reset() {
+	*x = ProgressInformation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_compact_activity_snapshot_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProgressInformation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProgressInformation) ProtoMessage() {}
+
+func (x *ProgressInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_compact_activity_snapshot_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProgressInformation.ProtoReflect.Descriptor instead.
+func (*ProgressInformation) Descriptor() ([]byte, []int) {
+	return file_compact_activity_snapshot_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProgressInformation) GetProgressIdentity() uint64 {
+	if x != nil {
+		return x.ProgressIdentity
+	}
+	return 0
+}
+
+func (x *ProgressInformation) GetRoleIdx() int32 {
+	if x != nil {
+		return x.RoleIdx
+	}
+	return 0
+}
+
+func (x *ProgressInformation) GetDatabaseIdx() int32 {
+	if x != nil {
+		return x.DatabaseIdx
+	}
+	return 0
+}
+
+func (x *ProgressInformation) GetRelationIdx() int32 {
+	if x != nil {
+		return x.RelationIdx
+	}
+	return 0
+}
+
+func (x *ProgressInformation) GetBackendIdentity() uint64 {
+	if x != nil {
+		return x.BackendIdentity
+	}
+	return 0
+}
+
+func (x *ProgressInformation) GetStartedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *ProgressInformation) GetCommand() ProgressInformation_Command {
+	if x != nil {
+		return x.Command
+	}
+	return ProgressInformation_UNKNOWN
+}
+
+func (x *ProgressInformation) GetCommandDetail() string {
+	if x != nil {
+		return x.CommandDetail
+	}
+	return ""
+}
+
+func (x *ProgressInformation) GetIndexName() string {
+	if x != nil {
+		return x.IndexName
+	}
+	return ""
+}
+
+type ProgressStatistic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProgressIdentity uint64               `protobuf:"varint,1,opt,name=progress_identity,json=progressIdentity,proto3" json:"progress_identity,omitempty"`
+	Phase            string               `protobuf:"bytes,2,opt,name=phase,proto3" json:"phase,omitempty"`
+	PhaseObservedAt  *timestamp.Timestamp `protobuf:"bytes,3,opt,name=phase_observed_at,json=phaseObservedAt,proto3" json:"phase_observed_at,omitempty"` // When the collector first saw the operation in the current phase
+	// Not every command reports all of these, unknown totals are zero
+	BlocksTotal int64 `protobuf:"varint,4,opt,name=blocks_total,json=blocksTotal,proto3" json:"blocks_total,omitempty"`
+	BlocksDone  int64 `protobuf:"varint,5,opt,name=blocks_done,json=blocksDone,proto3" json:"blocks_done,omitempty"`
+	TuplesTotal int64 `protobuf:"varint,6,opt,name=tuples_total,json=tuplesTotal,proto3" json:"tuples_total,omitempty"`
+	TuplesDone  int64 `protobuf:"varint,7,opt,name=tuples_done,json=tuplesDone,proto3" json:"tuples_done,omitempty"`
+	BytesTotal  int64 `protobuf:"varint,8,opt,name=bytes_total,json=bytesTotal,proto3" json:"bytes_total,omitempty"`
+	BytesDone   int64 `protobuf:"varint,9,opt,name=bytes_done,json=bytesDone,proto3" json:"bytes_done,omitempty"`
+	// Estimate for the current phase only, based on the progress since phase_observed_at
+	HasPhaseSecondsRemaining bool  `protobuf:"varint,10,opt,name=has_phase_seconds_remaining,json=hasPhaseSecondsRemaining,proto3" json:"has_phase_seconds_remaining,omitempty"`
+	PhaseSecondsRemaining    int64 `protobuf:"varint,11,opt,name=phase_seconds_remaining,json=phaseSecondsRemaining,proto3" json:"phase_seconds_remaining,omitempty"`
+}
+
+func (x *ProgressStatistic) Reset() {
+	*x = ProgressStatistic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_compact_activity_snapshot_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProgressStatistic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProgressStatistic) ProtoMessage() {}
+
+func (x *ProgressStatistic) ProtoReflect() protoreflect.Message {
+	mi := &file_compact_activity_snapshot_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProgressStatistic.ProtoReflect.Descriptor instead.
+func (*ProgressStatistic) Descriptor() ([]byte, []int) {
+	return file_compact_activity_snapshot_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ProgressStatistic) GetProgressIdentity() uint64 {
+	if x != nil {
+		return x.ProgressIdentity
+	}
+	return 0
+}
+
+func (x *ProgressStatistic) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *ProgressStatistic) GetPhaseObservedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.PhaseObservedAt
+	}
+	return nil
+}
+
+func (x *ProgressStatistic) GetBlocksTotal() int64 {
+	if x != nil {
+		return x.BlocksTotal
+	}
+	return 0
+}
+
+func (x *ProgressStatistic) GetBlocksDone() int64 {
+	if x != nil {
+		return x.BlocksDone
+	}
+	return 0
+}
+
+func (x *ProgressStatistic) GetTuplesTotal() int64 {
+	if x != nil {
+		return x.TuplesTotal
+	}
+	return 0
+}
+
+func (x *ProgressStatistic) GetTuplesDone() int64 {
+	if x != nil {
+		return x.TuplesDone
+	}
+	return 0
+}
+
+func (x *ProgressStatistic) GetBytesTotal() int64 {
+	if x != nil {
+		return x.BytesTotal
+	}
+	return 0
+}
+
+func (x *ProgressStatistic) GetBytesDone() int64 {
+	if x != nil {
+		return x.BytesDone
+	}
+	return 0
+}
+
+func (x *ProgressStatistic) GetHasPhaseSecondsRemaining() bool {
+	if x != nil {
+		return x.HasPhaseSecondsRemaining
+	}
+	return false
+}
+
+func (x *ProgressStatistic) GetPhaseSecondsRemaining() int64 {
+	if x != nil {
+		return x.PhaseSecondsRemaining
+	}
+	return 0
+}
+
 var File_compact_activity_snapshot_proto protoreflect.FileDescriptor
 
 var file_compact_activity_snapshot_proto_rawDesc = []byte{
@@ -1376,7 +1691,7 @@ var file_compact_activity_snapshot_proto_rawDesc = []byte{
 	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
 	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd9, 0x03, 0x0a, 0x17, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x91, 0x05, 0x0a, 0x17, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63,
 	0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
 	0x74, 0x12, 0x4f, 0x0a, 0x10, 0x70, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x5f, 0x76, 0x65,
 	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x67,
@@ -1406,662 +1721,735 @@ var file_compact_activity_snapshot_proto_rawDesc = []byte{
 	0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
 	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x18, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x50,
 	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
-	0x73, 0x22, 0x8e, 0x4b, 0x0a, 0x07, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x1a, 0x0a,
-	0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x68,
-	0x61, 0x73, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x78, 0x12, 0x19, 0x0a,
-	0x08, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x78, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x61, 0x73, 0x5f,
-	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0e, 0x68, 0x61, 0x73, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49,
-	0x64, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69,
-	0x64, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x49, 0x64, 0x78, 0x12, 0x22, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f, 0x71, 0x75, 0x65,
-	0x72, 0x79, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61,
-	0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x64, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x71, 0x75, 0x65,
-	0x72, 0x79, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x49, 0x64, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f,
-	0x74, 0x65, 0x78, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x54, 0x65, 0x78, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64,
-	0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x6f,
-	0x72, 0x74, 0x12, 0x3f, 0x0a, 0x0d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x78, 0x61, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x78, 0x61, 0x63, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x3b,
-	0x0a, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x0f, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x73, 0x12, 0x5d, 0x0a, 0x15, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x49,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x14, 0x70, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x57, 0x0a, 0x13, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0x8e, 0x4b, 0x0a, 0x07, 0x42, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x70, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x61, 0x73, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f,
+	0x69, 0x64, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x52, 0x6f,
+	0x6c, 0x65, 0x49, 0x64, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64,
+	0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x78,
+	0x12, 0x28, 0x0a, 0x10, 0x68, 0x61, 0x73, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x69, 0x64, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x68, 0x61, 0x73, 0x44,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x64, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x64, 0x78, 0x12, 0x22, 0x0a,
+	0x0d, 0x68, 0x61, 0x73, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x64,
+	0x78, 0x12, 0x1b, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x65, 0x72, 0x79, 0x49, 0x64, 0x78, 0x12, 0x1d,
+	0x0a, 0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x71, 0x75, 0x65, 0x72, 0x79, 0x54, 0x65, 0x78, 0x74, 0x12, 0x29, 0x0a,
+	0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x3f, 0x0a, 0x0d, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x61,
-	0x69, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x77, 0x61, 0x69,
-	0x74, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x12, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x61,
-	0x69, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x13, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
-	0x54, 0x79, 0x70, 0x65, 0x22, 0x91, 0x02, 0x0a, 0x0d, 0x57, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a,
-	0x14, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
-	0x4e, 0x41, 0x4d, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x47, 0x5f, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48,
-	0x45, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x4c,
-	0x4f, 0x43, 0x4b, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x50, 0x49, 0x4e, 0x10, 0x04, 0x12, 0x12, 0x0a,
-	0x0e, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
-	0x05, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54,
-	0x49, 0x56, 0x49, 0x54, 0x59, 0x10, 0x06, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x47, 0x5f, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x10, 0x07, 0x12, 0x15, 0x0a, 0x11, 0x50,
-	0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e,
-	0x10, 0x08, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x49, 0x50,
-	0x43, 0x10, 0x09, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x54,
-	0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x0a, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x47, 0x5f, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x49, 0x4f, 0x10, 0x0b, 0x22, 0xd7, 0x42, 0x0a, 0x09, 0x57, 0x61, 0x69,
-	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x26,
-	0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c,
-	0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x48, 0x4d, 0x45, 0x4d, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x5f,
-	0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x65, 0x12, 0x22, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4f, 0x49, 0x44, 0x5f,
-	0x47, 0x45, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x66, 0x12, 0x22, 0x0a, 0x1e, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
-	0x58, 0x49, 0x44, 0x5f, 0x47, 0x45, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x67, 0x12, 0x25,
-	0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c,
-	0x4f, 0x43, 0x4b, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x5f, 0x41, 0x52, 0x52, 0x41, 0x59, 0x5f, 0x4c,
-	0x4f, 0x43, 0x4b, 0x10, 0x68, 0x12, 0x27, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x5f, 0x49, 0x4e, 0x56,
-	0x41, 0x4c, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x69, 0x12, 0x28,
-	0x0a, 0x24, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c,
-	0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x5f, 0x57, 0x52, 0x49, 0x54,
-	0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x6a, 0x12, 0x2a, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x57, 0x41,
-	0x4c, 0x5f, 0x42, 0x55, 0x46, 0x5f, 0x4d, 0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x4f,
-	0x43, 0x4b, 0x10, 0x6b, 0x12, 0x24, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x57, 0x52,
-	0x49, 0x54, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x6c, 0x12, 0x27, 0x0a, 0x23, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
-	0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4c, 0x4f, 0x43,
-	0x4b, 0x10, 0x6d, 0x12, 0x25, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f,
-	0x49, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x6e, 0x12, 0x28, 0x0a, 0x24, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
-	0x43, 0x5f, 0x4c, 0x4f, 0x47, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4c, 0x4f,
-	0x43, 0x4b, 0x10, 0x6f, 0x12, 0x2b, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x55, 0x42, 0x54, 0x52, 0x41,
-	0x4e, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
-	0x70, 0x12, 0x29, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x5f, 0x58, 0x41, 0x43,
-	0x54, 0x5f, 0x47, 0x45, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x71, 0x12, 0x34, 0x0a, 0x30,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x78,
+	0x61, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x78, 0x61, 0x63,
+	0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x61, 0x69,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x61,
+	0x69, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x77, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x22, 0x91, 0x02, 0x0a,
+	0x0d, 0x57, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15,
+	0x0a, 0x11, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49,
+	0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x1a, 0x0a, 0x16, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43,
+	0x4b, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x50,
+	0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x03, 0x12, 0x16, 0x0a,
+	0x12, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x5f,
+	0x50, 0x49, 0x4e, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x47, 0x5f,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x10, 0x06, 0x12,
+	0x12, 0x0a, 0x0e, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e,
+	0x54, 0x10, 0x07, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x58, 0x54, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x08, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x47,
+	0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x49, 0x50, 0x43, 0x10, 0x09, 0x12, 0x13, 0x0a, 0x0f, 0x50,
+	0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x0a,
+	0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x49, 0x4f, 0x10, 0x0b,
+	0x22, 0xd7, 0x42, 0x0a, 0x09, 0x57, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x12, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x26, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x48, 0x4d, 0x45,
+	0x4d, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x65, 0x12, 0x22,
+	0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c,
+	0x4f, 0x43, 0x4b, 0x5f, 0x4f, 0x49, 0x44, 0x5f, 0x47, 0x45, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b,
+	0x10, 0x66, 0x12, 0x22, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x58, 0x49, 0x44, 0x5f, 0x47, 0x45, 0x4e, 0x5f,
+	0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x67, 0x12, 0x25, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x50, 0x52, 0x4f, 0x43,
+	0x5f, 0x41, 0x52, 0x52, 0x41, 0x59, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x68, 0x12, 0x27, 0x0a,
+	0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f,
+	0x43, 0x4b, 0x5f, 0x53, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f,
+	0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x69, 0x12, 0x28, 0x0a, 0x24, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x5f, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x6a,
+	0x12, 0x2a, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
+	0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x42, 0x55, 0x46, 0x5f, 0x4d, 0x41,
+	0x50, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x6b, 0x12, 0x24, 0x0a, 0x20,
 	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43,
-	0x4b, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x5f, 0x58, 0x41, 0x43, 0x54, 0x5f, 0x4f, 0x46, 0x46,
-	0x53, 0x45, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x4b,
-	0x10, 0x72, 0x12, 0x34, 0x0a, 0x30, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x5f, 0x58, 0x41,
-	0x43, 0x54, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f,
-	0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x73, 0x12, 0x29, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x45,
-	0x4c, 0x5f, 0x43, 0x41, 0x43, 0x48, 0x45, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x4c, 0x4f, 0x43,
-	0x4b, 0x10, 0x74, 0x12, 0x2c, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f,
-	0x49, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
-	0x75, 0x12, 0x2a, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x54, 0x57, 0x4f, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x76, 0x12, 0x2c, 0x0a,
-	0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f,
-	0x43, 0x4b, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x43, 0x52,
-	0x45, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x77, 0x12, 0x27, 0x0a, 0x23, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b,
-	0x5f, 0x42, 0x54, 0x52, 0x45, 0x45, 0x5f, 0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f, 0x4c, 0x4f,
-	0x43, 0x4b, 0x10, 0x78, 0x12, 0x2b, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x44, 0x44, 0x49, 0x4e, 0x5f,
-	0x53, 0x48, 0x4d, 0x45, 0x4d, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
-	0x79, 0x12, 0x25, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x56, 0x41, 0x43, 0x55, 0x55,
-	0x4d, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x7a, 0x12, 0x2e, 0x0a, 0x2a, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x55,
-	0x54, 0x4f, 0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c,
-	0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x7b, 0x12, 0x24, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x59,
-	0x4e, 0x43, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x7c, 0x12, 0x2b,
-	0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c,
-	0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x50,
-	0x50, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x7d, 0x12, 0x24, 0x0a, 0x20, 0x57,
+	0x4b, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b,
+	0x10, 0x6c, 0x12, 0x27, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f,
+	0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x6d, 0x12, 0x25, 0x0a, 0x21, 0x57,
 	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b,
-	0x5f, 0x41, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x43, 0x54, 0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
-	0x7e, 0x12, 0x26, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x51, 0x55, 0x45,
-	0x55, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x7f, 0x12, 0x32, 0x0a, 0x2d, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53,
-	0x45, 0x52, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x58, 0x41, 0x43, 0x54,
-	0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x80, 0x01, 0x12, 0x36, 0x0a,
-	0x31, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f,
-	0x43, 0x4b, 0x5f, 0x53, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x41, 0x42, 0x4c, 0x45, 0x5f,
-	0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x4c, 0x4f,
-	0x43, 0x4b, 0x10, 0x81, 0x01, 0x12, 0x3c, 0x0a, 0x37, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x45, 0x52, 0x49, 0x41,
-	0x4c, 0x49, 0x5a, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x44, 0x49, 0x43, 0x41, 0x54,
-	0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b,
-	0x10, 0x82, 0x01, 0x12, 0x27, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4f, 0x4c, 0x44, 0x5f, 0x53, 0x45, 0x52,
-	0x5f, 0x58, 0x49, 0x44, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x83, 0x01, 0x12, 0x24, 0x0a, 0x1f,
+	0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b,
+	0x10, 0x6e, 0x12, 0x28, 0x0a, 0x24, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x43, 0x5f, 0x4c, 0x4f, 0x47, 0x5f, 0x43, 0x4f,
+	0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x6f, 0x12, 0x2b, 0x0a, 0x27,
 	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43,
-	0x4b, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x52, 0x45, 0x50, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
-	0x84, 0x01, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x47, 0x52, 0x4f, 0x55,
-	0x4e, 0x44, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x85,
-	0x01, 0x12, 0x38, 0x0a, 0x33, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x44, 0x59, 0x4e, 0x41, 0x4d, 0x49, 0x43, 0x5f, 0x53,
-	0x48, 0x41, 0x52, 0x44, 0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x54,
-	0x52, 0x4f, 0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x86, 0x01, 0x12, 0x25, 0x0a, 0x20, 0x57,
+	0x4b, 0x5f, 0x53, 0x55, 0x42, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52,
+	0x4f, 0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x70, 0x12, 0x29, 0x0a, 0x25, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4d,
+	0x55, 0x4c, 0x54, 0x49, 0x5f, 0x58, 0x41, 0x43, 0x54, 0x5f, 0x47, 0x45, 0x4e, 0x5f, 0x4c, 0x4f,
+	0x43, 0x4b, 0x10, 0x71, 0x12, 0x34, 0x0a, 0x30, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x5f,
+	0x58, 0x41, 0x43, 0x54, 0x5f, 0x4f, 0x46, 0x46, 0x53, 0x45, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54,
+	0x52, 0x4f, 0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x72, 0x12, 0x34, 0x0a, 0x30, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
+	0x4d, 0x55, 0x4c, 0x54, 0x49, 0x5f, 0x58, 0x41, 0x43, 0x54, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45,
+	0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x73,
+	0x12, 0x29, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
+	0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x4c, 0x5f, 0x43, 0x41, 0x43, 0x48, 0x45, 0x5f,
+	0x49, 0x4e, 0x49, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x74, 0x12, 0x2c, 0x0a, 0x28, 0x57,
 	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b,
-	0x5f, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
-	0x87, 0x01, 0x12, 0x37, 0x0a, 0x32, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x88, 0x01, 0x12, 0x34, 0x0a, 0x2f, 0x57,
+	0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x43, 0x4f,
+	0x4d, 0x4d, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x75, 0x12, 0x2a, 0x0a, 0x26, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x54,
+	0x57, 0x4f, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4c,
+	0x4f, 0x43, 0x4b, 0x10, 0x76, 0x12, 0x2c, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45,
+	0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x4f, 0x43,
+	0x4b, 0x10, 0x77, 0x12, 0x27, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x42, 0x54, 0x52, 0x45, 0x45, 0x5f, 0x56,
+	0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x78, 0x12, 0x2b, 0x0a, 0x27,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43,
+	0x4b, 0x5f, 0x41, 0x44, 0x44, 0x49, 0x4e, 0x5f, 0x53, 0x48, 0x4d, 0x45, 0x4d, 0x5f, 0x49, 0x4e,
+	0x49, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x79, 0x12, 0x25, 0x0a, 0x21, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x41,
+	0x55, 0x54, 0x4f, 0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x7a,
+	0x12, 0x2e, 0x0a, 0x2a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
+	0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x56, 0x41, 0x43, 0x55, 0x55, 0x4d,
+	0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x7b,
+	0x12, 0x24, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
+	0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x5f,
+	0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x7c, 0x12, 0x2b, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x4c, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x4f, 0x43,
+	0x4b, 0x10, 0x7d, 0x12, 0x24, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x43,
+	0x54, 0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x7e, 0x12, 0x26, 0x0a, 0x22, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x41,
+	0x53, 0x59, 0x4e, 0x43, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
+	0x7f, 0x12, 0x32, 0x0a, 0x2d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x41,
+	0x42, 0x4c, 0x45, 0x5f, 0x58, 0x41, 0x43, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x4c, 0x4f,
+	0x43, 0x4b, 0x10, 0x80, 0x01, 0x12, 0x36, 0x0a, 0x31, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x45, 0x52, 0x49, 0x41,
+	0x4c, 0x49, 0x5a, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44,
+	0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x81, 0x01, 0x12, 0x3c, 0x0a,
+	0x37, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f,
+	0x43, 0x4b, 0x5f, 0x53, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x41, 0x42, 0x4c, 0x45, 0x5f,
+	0x50, 0x52, 0x45, 0x44, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4c,
+	0x49, 0x53, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x82, 0x01, 0x12, 0x27, 0x0a, 0x22, 0x57,
 	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b,
-	0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f,
-	0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x89,
-	0x01, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x54, 0x53,
-	0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x8a, 0x01,
-	0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
-	0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x54, 0x53, 0x5f,
-	0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x8b, 0x01, 0x12, 0x2e, 0x0a, 0x29, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x50,
-	0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x5f,
-	0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x8c, 0x01, 0x12, 0x31, 0x0a, 0x2c, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4d, 0x55, 0x4c,
-	0x54, 0x49, 0x5f, 0x58, 0x41, 0x43, 0x54, 0x5f, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x8d, 0x01, 0x12, 0x31, 0x0a, 0x2c, 0x57, 0x41,
+	0x5f, 0x4f, 0x4c, 0x44, 0x5f, 0x53, 0x45, 0x52, 0x5f, 0x58, 0x49, 0x44, 0x5f, 0x4c, 0x4f, 0x43,
+	0x4b, 0x10, 0x83, 0x01, 0x12, 0x24, 0x0a, 0x1f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x52,
+	0x45, 0x50, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x84, 0x01, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
+	0x42, 0x41, 0x43, 0x4b, 0x47, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x45,
+	0x52, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x85, 0x01, 0x12, 0x38, 0x0a, 0x33, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x44,
+	0x59, 0x4e, 0x41, 0x4d, 0x49, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x52, 0x44, 0x5f, 0x4d, 0x45, 0x4d,
+	0x4f, 0x52, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x4b,
+	0x10, 0x86, 0x01, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x46, 0x49,
+	0x4c, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x87, 0x01, 0x12, 0x37, 0x0a, 0x32, 0x57, 0x41,
 	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
-	0x4f, 0x4c, 0x44, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x5f, 0x54, 0x49, 0x4d,
-	0x45, 0x5f, 0x4d, 0x41, 0x50, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x8e, 0x01, 0x12, 0x2a, 0x0a,
-	0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f,
-	0x43, 0x4b, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x45, 0x4e, 0x44, 0x5f, 0x52, 0x41, 0x4e, 0x44, 0x4f,
-	0x4d, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x8f, 0x01, 0x12, 0x2e, 0x0a, 0x29, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4c,
-	0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x50, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x45,
-	0x52, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x90, 0x01, 0x12, 0x2b, 0x0a, 0x26, 0x57, 0x41, 0x49,
+	0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54,
+	0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b,
+	0x10, 0x88, 0x01, 0x12, 0x34, 0x0a, 0x2f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f,
+	0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x89, 0x01, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49,
 	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x43,
-	0x4c, 0x4f, 0x47, 0x5f, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c,
-	0x4f, 0x43, 0x4b, 0x10, 0x91, 0x01, 0x12, 0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x43,
-	0x4c, 0x4f, 0x47, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x53, 0x10, 0x92, 0x01, 0x12, 0x2a,
-	0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54,
-	0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x54, 0x53, 0x5f,
-	0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x53, 0x10, 0x93, 0x01, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43,
-	0x48, 0x45, 0x5f, 0x53, 0x55, 0x42, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x5f, 0x42, 0x55, 0x46, 0x46,
-	0x45, 0x52, 0x53, 0x10, 0x94, 0x01, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x4d,
-	0x58, 0x41, 0x43, 0x54, 0x4f, 0x46, 0x46, 0x53, 0x45, 0x54, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45,
-	0x52, 0x53, 0x10, 0x95, 0x01, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x4d, 0x58,
-	0x41, 0x43, 0x54, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52,
-	0x53, 0x10, 0x96, 0x01, 0x12, 0x27, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x41, 0x53, 0x59,
-	0x4e, 0x43, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x53, 0x10, 0x97, 0x01, 0x12, 0x2b, 0x0a,
-	0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52,
-	0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x4f, 0x4c, 0x44, 0x53, 0x45, 0x52, 0x58, 0x49, 0x44, 0x5f,
-	0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x53, 0x10, 0x98, 0x01, 0x12, 0x24, 0x0a, 0x1f, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43,
-	0x48, 0x45, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x10, 0x99, 0x01,
-	0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
-	0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x5f,
-	0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x10, 0x9a, 0x01, 0x12, 0x2f, 0x0a, 0x2a, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43,
-	0x48, 0x45, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x49, 0x4f, 0x5f, 0x49, 0x4e, 0x5f,
-	0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x9b, 0x01, 0x12, 0x2c, 0x0a, 0x27, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e,
-	0x43, 0x48, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x10, 0x9c, 0x01, 0x12, 0x39, 0x0a, 0x34, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48,
-	0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c,
-	0x4f, 0x54, 0x5f, 0x49, 0x4f, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53,
-	0x53, 0x10, 0x9d, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x50, 0x52, 0x4f,
-	0x43, 0x10, 0x9e, 0x01, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x42, 0x55, 0x46,
-	0x46, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x10, 0x9f, 0x01, 0x12, 0x26,
+	0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x54, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c,
+	0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x8a, 0x01, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x43, 0x4f,
+	0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x54, 0x53, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x8b, 0x01, 0x12,
+	0x2e, 0x0a, 0x29, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57,
+	0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x8c, 0x01, 0x12,
+	0x31, 0x0a, 0x2c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57,
+	0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x5f, 0x58, 0x41, 0x43, 0x54, 0x5f,
+	0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
+	0x8d, 0x01, 0x12, 0x31, 0x0a, 0x2c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4f, 0x4c, 0x44, 0x5f, 0x53, 0x4e, 0x41, 0x50,
+	0x53, 0x48, 0x4f, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x4d, 0x41, 0x50, 0x5f, 0x4c, 0x4f,
+	0x43, 0x4b, 0x10, 0x8e, 0x01, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x45,
+	0x4e, 0x44, 0x5f, 0x52, 0x41, 0x4e, 0x44, 0x4f, 0x4d, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x8f,
+	0x01, 0x12, 0x2e, 0x0a, 0x29, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52,
+	0x45, 0x50, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x90,
+	0x01, 0x12, 0x2b, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x43, 0x4c, 0x4f, 0x47, 0x5f, 0x54, 0x52, 0x55, 0x4e,
+	0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x91, 0x01, 0x12, 0x26,
 	0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54,
-	0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4d, 0x41, 0x4e, 0x41,
-	0x47, 0x45, 0x52, 0x10, 0xa0, 0x01, 0x12, 0x30, 0x0a, 0x2b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x50,
-	0x52, 0x45, 0x44, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4d, 0x41,
-	0x4e, 0x41, 0x47, 0x45, 0x52, 0x10, 0xa1, 0x01, 0x12, 0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45,
-	0x5f, 0x50, 0x41, 0x52, 0x41, 0x4c, 0x4c, 0x45, 0x4c, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x4a,
-	0x4f, 0x49, 0x4e, 0x10, 0xa2, 0x01, 0x12, 0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x50,
-	0x41, 0x52, 0x41, 0x4c, 0x4c, 0x45, 0x4c, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x44, 0x53,
-	0x41, 0x10, 0xa3, 0x01, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x53, 0x45, 0x53,
-	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x53, 0x41, 0x10, 0xa4, 0x01, 0x12, 0x2e, 0x0a, 0x29, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e,
-	0x43, 0x48, 0x45, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x43, 0x4f,
-	0x52, 0x44, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0xa5, 0x01, 0x12, 0x2e, 0x0a, 0x29, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e,
-	0x43, 0x48, 0x45, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x4d,
-	0x4f, 0x44, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0xa6, 0x01, 0x12, 0x2b, 0x0a, 0x26, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e,
-	0x43, 0x48, 0x45, 0x5f, 0x53, 0x48, 0x41, 0x52, 0x45, 0x44, 0x5f, 0x54, 0x55, 0x50, 0x4c, 0x45,
-	0x53, 0x54, 0x4f, 0x52, 0x45, 0x10, 0xa7, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49, 0x54,
+	0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x47, 0x5f, 0x42, 0x55, 0x46, 0x46,
+	0x45, 0x52, 0x53, 0x10, 0x92, 0x01, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x43,
+	0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x54, 0x53, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x53, 0x10,
+	0x93, 0x01, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x53, 0x55, 0x42, 0x54, 0x52,
+	0x41, 0x4e, 0x53, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x53, 0x10, 0x94, 0x01, 0x12, 0x2d,
+	0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54,
+	0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x4d, 0x58, 0x41, 0x43, 0x54, 0x4f, 0x46, 0x46, 0x53,
+	0x45, 0x54, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x53, 0x10, 0x95, 0x01, 0x12, 0x2d, 0x0a,
+	0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52,
+	0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x4d, 0x58, 0x41, 0x43, 0x54, 0x4d, 0x45, 0x4d, 0x42, 0x45,
+	0x52, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x53, 0x10, 0x96, 0x01, 0x12, 0x27, 0x0a, 0x22,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41,
+	0x4e, 0x43, 0x48, 0x45, 0x5f, 0x41, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45,
+	0x52, 0x53, 0x10, 0x97, 0x01, 0x12, 0x2b, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x4f, 0x4c,
+	0x44, 0x53, 0x45, 0x52, 0x58, 0x49, 0x44, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x53, 0x10,
+	0x98, 0x01, 0x12, 0x24, 0x0a, 0x1f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x49,
+	0x4e, 0x53, 0x45, 0x52, 0x54, 0x10, 0x99, 0x01, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54,
 	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45,
-	0x5f, 0x54, 0x42, 0x4d, 0x10, 0xa8, 0x01, 0x12, 0x29, 0x0a, 0x24, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f,
-	0x50, 0x41, 0x52, 0x41, 0x4c, 0x4c, 0x45, 0x4c, 0x5f, 0x41, 0x50, 0x50, 0x45, 0x4e, 0x44, 0x10,
-	0xa9, 0x01, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f,
-	0x4e, 0x10, 0xc8, 0x01, 0x12, 0x27, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x44, 0x10, 0xc9, 0x01, 0x12, 0x1c, 0x0a,
-	0x17, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b,
-	0x54, 0x41, 0x47, 0x5f, 0x50, 0x41, 0x47, 0x45, 0x10, 0xca, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41,
-	0x47, 0x5f, 0x54, 0x55, 0x50, 0x4c, 0x45, 0x10, 0xcb, 0x01, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41,
+	0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x10,
+	0x9a, 0x01, 0x12, 0x2f, 0x0a, 0x2a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45,
+	0x52, 0x5f, 0x49, 0x4f, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53,
+	0x10, 0x9b, 0x01, 0x12, 0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c,
+	0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x10, 0x9c,
+	0x01, 0x12, 0x39, 0x0a, 0x34, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x49, 0x4f, 0x5f, 0x49, 0x4e,
+	0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x9d, 0x01, 0x12, 0x1e, 0x0a, 0x19,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41,
+	0x4e, 0x43, 0x48, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x10, 0x9e, 0x01, 0x12, 0x28, 0x0a, 0x23,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41,
+	0x4e, 0x43, 0x48, 0x45, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x50, 0x50,
+	0x49, 0x4e, 0x47, 0x10, 0x9f, 0x01, 0x12, 0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x4c,
+	0x4f, 0x43, 0x4b, 0x5f, 0x4d, 0x41, 0x4e, 0x41, 0x47, 0x45, 0x52, 0x10, 0xa0, 0x01, 0x12, 0x30,
+	0x0a, 0x2b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54,
+	0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x44, 0x49, 0x43, 0x41, 0x54, 0x45,
+	0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4d, 0x41, 0x4e, 0x41, 0x47, 0x45, 0x52, 0x10, 0xa1, 0x01,
+	0x12, 0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
+	0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4c, 0x4c, 0x45,
+	0x4c, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x4a, 0x4f, 0x49, 0x4e, 0x10, 0xa2, 0x01, 0x12, 0x2c,
+	0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54,
+	0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4c, 0x4c, 0x45, 0x4c, 0x5f,
+	0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x44, 0x53, 0x41, 0x10, 0xa3, 0x01, 0x12, 0x25, 0x0a, 0x20,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41,
+	0x4e, 0x43, 0x48, 0x45, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x53, 0x41,
+	0x10, 0xa4, 0x01, 0x12, 0x2e, 0x0a, 0x29, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x53, 0x45, 0x53, 0x53,
+	0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45,
+	0x10, 0xa5, 0x01, 0x12, 0x2e, 0x0a, 0x29, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x53, 0x45, 0x53, 0x53,
+	0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x4d, 0x4f, 0x44, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45,
+	0x10, 0xa6, 0x01, 0x12, 0x2b, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x53, 0x48, 0x41, 0x52,
+	0x45, 0x44, 0x5f, 0x54, 0x55, 0x50, 0x4c, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x10, 0xa7, 0x01,
+	0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
+	0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x54, 0x42, 0x4d, 0x10, 0xa8, 0x01, 0x12,
+	0x29, 0x0a, 0x24, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57,
+	0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4c, 0x4c, 0x45, 0x4c,
+	0x5f, 0x41, 0x50, 0x50, 0x45, 0x4e, 0x44, 0x10, 0xa9, 0x01, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41,
 	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47,
-	0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xcc, 0x01, 0x12,
-	0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f,
-	0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x54, 0x52, 0x41,
-	0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xcd, 0x01, 0x12, 0x29, 0x0a, 0x24, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41,
-	0x47, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x54, 0x4f,
-	0x4b, 0x45, 0x4e, 0x10, 0xce, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x4f, 0x42, 0x4a,
-	0x45, 0x43, 0x54, 0x10, 0xcf, 0x01, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x55, 0x53, 0x45,
-	0x52, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0xd0, 0x01, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x41,
-	0x44, 0x56, 0x49, 0x53, 0x4f, 0x52, 0x59, 0x10, 0xd1, 0x01, 0x12, 0x1a, 0x0a, 0x15, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x5f,
-	0x50, 0x49, 0x4e, 0x10, 0xac, 0x02, 0x12, 0x19, 0x0a, 0x14, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x90,
-	0x03, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x50, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x4d, 0x45, 0x4e,
-	0x54, 0x53, 0x10, 0x91, 0x03, 0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49,
-	0x4e, 0x10, 0xf4, 0x03, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f, 0x4d, 0x41,
-	0x49, 0x4e, 0x10, 0xf5, 0x03, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x42, 0x47, 0x57, 0x52, 0x49, 0x54, 0x45, 0x52, 0x5f, 0x48, 0x49, 0x42,
-	0x45, 0x52, 0x4e, 0x41, 0x54, 0x45, 0x10, 0xf6, 0x03, 0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x47, 0x57, 0x52, 0x49, 0x54, 0x45, 0x52,
-	0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0xf7, 0x03, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e,
-	0x54, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0xf8, 0x03, 0x12, 0x22, 0x0a, 0x1d, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41,
-	0x4c, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x59, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0xf9, 0x03, 0x12,
-	0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f,
-	0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x4c, 0x41, 0x55, 0x4e, 0x43, 0x48, 0x45, 0x52, 0x5f, 0x4d,
-	0x41, 0x49, 0x4e, 0x10, 0xfa, 0x03, 0x12, 0x1b, 0x0a, 0x16, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x47, 0x53, 0x54, 0x41, 0x54, 0x5f, 0x4d, 0x41, 0x49, 0x4e,
-	0x10, 0xfb, 0x03, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x41,
-	0x4c, 0x4c, 0x10, 0xfc, 0x03, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x57, 0x41, 0x4c,
-	0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x10, 0xfd, 0x03, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x59, 0x53, 0x4c, 0x4f, 0x47, 0x47,
-	0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0xfe, 0x03, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x43,
-	0x45, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0xff, 0x03, 0x12, 0x1f, 0x0a,
-	0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f,
-	0x53, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0x80, 0x04, 0x12, 0x1f,
-	0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c,
-	0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0x81, 0x04, 0x12,
-	0x1b, 0x0a, 0x16, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4c,
-	0x49, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xd8, 0x04, 0x12, 0x1c, 0x0a, 0x17,
-	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e,
-	0x54, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xd9, 0x04, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x49, 0x42, 0x50, 0x51, 0x57, 0x41,
-	0x4c, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43,
-	0x54, 0x10, 0xda, 0x04, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x4c, 0x49, 0x42, 0x50, 0x51, 0x57, 0x41, 0x4c, 0x52, 0x45, 0x43, 0x45, 0x49,
-	0x56, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x10, 0xdb, 0x04, 0x12, 0x1f,
-	0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x53, 0x4c,
-	0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x10, 0xdc, 0x04, 0x12,
-	0x27, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41,
-	0x4c, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0xdd, 0x04, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x45,
-	0x52, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x10, 0xde, 0x04, 0x12, 0x25, 0x0a,
-	0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f,
-	0x53, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x44, 0x41, 0x54,
-	0x41, 0x10, 0xdf, 0x04, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x47, 0x53, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x53, 0x45, 0x52, 0x56,
-	0x45, 0x52, 0x10, 0xe0, 0x04, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x42, 0x47, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x5f, 0x53, 0x48, 0x55,
-	0x54, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0xbc, 0x05, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x47, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x5f,
-	0x53, 0x54, 0x41, 0x52, 0x54, 0x55, 0x50, 0x10, 0xbd, 0x05, 0x12, 0x1a, 0x0a, 0x15, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x54, 0x52, 0x45, 0x45, 0x5f, 0x50,
-	0x41, 0x47, 0x45, 0x10, 0xbe, 0x05, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4c, 0x4f, 0x47, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f,
-	0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0xbf, 0x05, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x45, 0x5f,
-	0x47, 0x41, 0x54, 0x48, 0x45, 0x52, 0x10, 0xc0, 0x05, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x42, 0x41, 0x54,
-	0x43, 0x48, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0xc1, 0x05,
-	0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48,
-	0x41, 0x53, 0x48, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49,
-	0x4e, 0x47, 0x10, 0xc2, 0x05, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x4c,
-	0x4f, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x10, 0xc3, 0x05, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x42, 0x55, 0x49,
-	0x4c, 0x44, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0xc4, 0x05,
-	0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48,
-	0x41, 0x53, 0x48, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49,
-	0x4e, 0x47, 0x10, 0xc5, 0x05, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x48,
-	0x41, 0x53, 0x48, 0x49, 0x4e, 0x47, 0x5f, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x10, 0xc6, 0x05, 0x12,
-	0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41,
-	0x53, 0x48, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x49, 0x4e, 0x47,
-	0x5f, 0x4f, 0x55, 0x54, 0x45, 0x52, 0x10, 0xc7, 0x05, 0x12, 0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f,
-	0x57, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x45, 0x53, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41,
-	0x54, 0x49, 0x4e, 0x47, 0x10, 0xc8, 0x05, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f, 0x57, 0x5f,
-	0x42, 0x41, 0x54, 0x43, 0x48, 0x45, 0x53, 0x5f, 0x44, 0x45, 0x43, 0x49, 0x44, 0x49, 0x4e, 0x47,
-	0x10, 0xc9, 0x05, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x41, 0x54, 0x43,
-	0x48, 0x45, 0x53, 0x5f, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4e, 0x47, 0x10, 0xca, 0x05, 0x12,
-	0x2b, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41,
+	0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xc8, 0x01, 0x12, 0x27, 0x0a, 0x22,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54,
+	0x41, 0x47, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x54, 0x45,
+	0x4e, 0x44, 0x10, 0xc9, 0x01, 0x12, 0x1c, 0x0a, 0x17, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x50, 0x41, 0x47, 0x45,
+	0x10, 0xca, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x54, 0x55, 0x50, 0x4c, 0x45, 0x10,
+	0xcb, 0x01, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x10, 0xcc, 0x01, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x56, 0x49,
+	0x52, 0x54, 0x55, 0x41, 0x4c, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x10, 0xcd, 0x01, 0x12, 0x29, 0x0a, 0x24, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x55, 0x4c,
+	0x41, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0xce, 0x01, 0x12, 0x1e,
+	0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43,
+	0x4b, 0x54, 0x41, 0x47, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x10, 0xcf, 0x01, 0x12, 0x20,
+	0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43,
+	0x4b, 0x54, 0x41, 0x47, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0xd0, 0x01,
+	0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
+	0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x41, 0x44, 0x56, 0x49, 0x53, 0x4f, 0x52, 0x59, 0x10,
+	0xd1, 0x01, 0x12, 0x1a, 0x0a, 0x15, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x50, 0x49, 0x4e, 0x10, 0xac, 0x02, 0x12, 0x19,
+	0x0a, 0x14, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x45, 0x58, 0x54,
+	0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x90, 0x03, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x53, 0x10, 0x91, 0x03, 0x12, 0x1d, 0x0a,
+	0x18, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x52, 0x43, 0x48,
+	0x49, 0x56, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0xf4, 0x03, 0x12, 0x1f, 0x0a, 0x1a,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x56,
+	0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0xf5, 0x03, 0x12, 0x22, 0x0a,
+	0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x47, 0x57, 0x52,
+	0x49, 0x54, 0x45, 0x52, 0x5f, 0x48, 0x49, 0x42, 0x45, 0x52, 0x4e, 0x41, 0x54, 0x45, 0x10, 0xf6,
+	0x03, 0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x42, 0x47, 0x57, 0x52, 0x49, 0x54, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0xf7, 0x03,
+	0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43,
+	0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e,
+	0x10, 0xf8, 0x03, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x59, 0x5f,
+	0x4d, 0x41, 0x49, 0x4e, 0x10, 0xf9, 0x03, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x4c, 0x41,
+	0x55, 0x4e, 0x43, 0x48, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0xfa, 0x03, 0x12, 0x1b,
+	0x0a, 0x16, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x47, 0x53,
+	0x54, 0x41, 0x54, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0xfb, 0x03, 0x12, 0x20, 0x0a, 0x1b, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45,
+	0x52, 0x59, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0xfc, 0x03, 0x12, 0x23, 0x0a,
+	0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x43, 0x4f,
+	0x56, 0x45, 0x52, 0x59, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x10,
+	0xfd, 0x03, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x53, 0x59, 0x53, 0x4c, 0x4f, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10,
+	0xfe, 0x03, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x4d, 0x41,
+	0x49, 0x4e, 0x10, 0xff, 0x03, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4d,
+	0x41, 0x49, 0x4e, 0x10, 0x80, 0x04, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x52, 0x5f,
+	0x4d, 0x41, 0x49, 0x4e, 0x10, 0x81, 0x04, 0x12, 0x1b, 0x0a, 0x16, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x41,
+	0x44, 0x10, 0xd8, 0x04, 0x12, 0x1c, 0x0a, 0x17, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10,
+	0xd9, 0x04, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4c, 0x49, 0x42, 0x50, 0x51, 0x57, 0x41, 0x4c, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45,
+	0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x10, 0xda, 0x04, 0x12, 0x28, 0x0a, 0x23,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x49, 0x42, 0x50, 0x51,
+	0x57, 0x41, 0x4c, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x43, 0x45,
+	0x49, 0x56, 0x45, 0x10, 0xdb, 0x04, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x53, 0x4c, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x53, 0x45,
+	0x52, 0x56, 0x45, 0x52, 0x10, 0xdc, 0x04, 0x12, 0x27, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56,
+	0x45, 0x52, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0xdd, 0x04,
+	0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57,
+	0x41, 0x4c, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x57,
+	0x41, 0x4c, 0x10, 0xde, 0x04, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x57,
+	0x52, 0x49, 0x54, 0x45, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10, 0xdf, 0x04, 0x12, 0x1f, 0x0a, 0x1a,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x47, 0x53, 0x53, 0x5f, 0x4f,
+	0x50, 0x45, 0x4e, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x10, 0xe0, 0x04, 0x12, 0x21, 0x0a,
+	0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x47, 0x57, 0x4f,
+	0x52, 0x4b, 0x45, 0x52, 0x5f, 0x53, 0x48, 0x55, 0x54, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0xbc, 0x05,
+	0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42,
+	0x47, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x55, 0x50, 0x10,
+	0xbd, 0x05, 0x12, 0x1a, 0x0a, 0x15, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x42, 0x54, 0x52, 0x45, 0x45, 0x5f, 0x50, 0x41, 0x47, 0x45, 0x10, 0xbe, 0x05, 0x12, 0x21,
+	0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4c, 0x4f,
+	0x47, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0xbf,
+	0x05, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x45, 0x5f, 0x47, 0x41, 0x54, 0x48, 0x45, 0x52, 0x10, 0xc0,
+	0x05, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x48, 0x41, 0x53, 0x48, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43,
+	0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0xc1, 0x05, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x42, 0x41, 0x54, 0x43,
+	0x48, 0x5f, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4e, 0x47, 0x10, 0xc2, 0x05, 0x12, 0x22, 0x0a,
+	0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48,
+	0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x4c, 0x4f, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x10, 0xc3,
+	0x05, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x48, 0x41, 0x53, 0x48, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43,
+	0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0xc4, 0x05, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x42, 0x55, 0x49, 0x4c,
+	0x44, 0x5f, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4e, 0x47, 0x10, 0xc5, 0x05, 0x12, 0x28, 0x0a,
+	0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48,
+	0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x49, 0x4e, 0x47, 0x5f, 0x49,
+	0x4e, 0x4e, 0x45, 0x52, 0x10, 0xc6, 0x05, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44,
+	0x5f, 0x48, 0x41, 0x53, 0x48, 0x49, 0x4e, 0x47, 0x5f, 0x4f, 0x55, 0x54, 0x45, 0x52, 0x10, 0xc7,
+	0x05, 0x12, 0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x48, 0x41, 0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x45,
+	0x53, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0xc8, 0x05, 0x12,
+	0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41,
 	0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x45, 0x53, 0x5f,
-	0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x49, 0x4e, 0x47, 0x10, 0xcb, 0x05, 0x12, 0x30, 0x0a, 0x2b,
-	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f,
-	0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x45, 0x53, 0x5f, 0x52, 0x45, 0x50,
-	0x41, 0x52, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0xcc, 0x05, 0x12, 0x2c,
-	0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53,
-	0x48, 0x5f, 0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x5f, 0x41,
-	0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0xcd, 0x05, 0x12, 0x2a, 0x0a, 0x25,
-	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f,
-	0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x5f, 0x45, 0x4c, 0x45,
-	0x43, 0x54, 0x49, 0x4e, 0x47, 0x10, 0xce, 0x05, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f, 0x57,
-	0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x5f, 0x52, 0x45, 0x49, 0x4e, 0x53, 0x45, 0x52,
-	0x54, 0x49, 0x4e, 0x47, 0x10, 0xcf, 0x05, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x53, 0x59,
-	0x4e, 0x43, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10, 0xd0, 0x05, 0x12, 0x29, 0x0a, 0x24, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c,
-	0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e,
-	0x47, 0x45, 0x10, 0xd1, 0x05, 0x12, 0x1b, 0x0a, 0x16, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x51, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10,
-	0xd2, 0x05, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x4d, 0x51, 0x5f, 0x50, 0x55, 0x54, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x10,
-	0xd3, 0x05, 0x12, 0x1a, 0x0a, 0x15, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x4d, 0x51, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x10, 0xd4, 0x05, 0x12, 0x17,
-	0x0a, 0x12, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x51, 0x5f,
-	0x53, 0x45, 0x4e, 0x44, 0x10, 0xd5, 0x05, 0x12, 0x24, 0x0a, 0x1f, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4c, 0x4c, 0x45, 0x4c, 0x5f, 0x42,
-	0x49, 0x54, 0x4d, 0x41, 0x50, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0xd6, 0x05, 0x12, 0x2a, 0x0a,
-	0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x41, 0x52, 0x41,
-	0x4c, 0x4c, 0x45, 0x4c, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x44, 0x45,
-	0x58, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0xd7, 0x05, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4c, 0x4c, 0x45, 0x4c,
-	0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x10, 0xd8, 0x05, 0x12, 0x26, 0x0a, 0x21, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x41, 0x52, 0x52,
-	0x41, 0x59, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10,
-	0xd9, 0x05, 0x12, 0x17, 0x0a, 0x12, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x45, 0x10, 0xda, 0x05, 0x12, 0x27, 0x0a, 0x22, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43,
-	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x5f, 0x44, 0x52, 0x4f,
-	0x50, 0x10, 0xdb, 0x05, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
-	0x4c, 0x4f, 0x54, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x10, 0xdc, 0x05, 0x12, 0x1d, 0x0a, 0x18, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x41, 0x46, 0x45, 0x5f, 0x53,
-	0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x10, 0xdd, 0x05, 0x12, 0x18, 0x0a, 0x13, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x52, 0x45,
-	0x50, 0x10, 0xde, 0x05, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x44, 0x4f,
-	0x4e, 0x45, 0x10, 0xdf, 0x05, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x53,
-	0x54, 0x41, 0x52, 0x54, 0x10, 0xe0, 0x05, 0x12, 0x24, 0x0a, 0x1f, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x55,
-	0x50, 0x5f, 0x54, 0x48, 0x52, 0x4f, 0x54, 0x54, 0x4c, 0x45, 0x10, 0xa0, 0x06, 0x12, 0x18, 0x0a,
-	0x13, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x47, 0x5f, 0x53,
-	0x4c, 0x45, 0x45, 0x50, 0x10, 0xa1, 0x06, 0x12, 0x24, 0x0a, 0x1f, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x41,
-	0x50, 0x50, 0x4c, 0x59, 0x5f, 0x44, 0x45, 0x4c, 0x41, 0x59, 0x10, 0xa2, 0x06, 0x12, 0x1c, 0x0a,
-	0x17, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x55, 0x46, 0x46,
-	0x49, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x84, 0x07, 0x12, 0x1d, 0x0a, 0x18, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x49, 0x4c,
-	0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x85, 0x07, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c,
-	0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x86, 0x07, 0x12, 0x21, 0x0a,
-	0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54,
-	0x52, 0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x87, 0x07,
-	0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43,
-	0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43,
-	0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x88, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c,
-	0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x89, 0x07, 0x12, 0x29,
-	0x0a, 0x24, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4e,
-	0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f,
-	0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x8a, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x50, 0x59, 0x5f, 0x46, 0x49, 0x4c,
-	0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x8b, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x50, 0x59, 0x5f, 0x46, 0x49, 0x4c,
-	0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x8c, 0x07, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46, 0x49,
-	0x4c, 0x45, 0x5f, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x44, 0x10, 0x8d, 0x07, 0x12, 0x1f, 0x0a, 0x1a,
-	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f,
-	0x46, 0x49, 0x4c, 0x45, 0x5f, 0x46, 0x4c, 0x55, 0x53, 0x48, 0x10, 0x8e, 0x07, 0x12, 0x28, 0x0a,
-	0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41,
-	0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x49, 0x4d, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x54, 0x45, 0x5f,
-	0x53, 0x59, 0x4e, 0x43, 0x10, 0x8f, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x44, 0x45, 0x43, 0x49, 0x44, 0x49, 0x4e, 0x47, 0x10, 0xc9, 0x05, 0x12, 0x2a, 0x0a, 0x25, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x47,
+	0x52, 0x4f, 0x57, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x45, 0x53, 0x5f, 0x45, 0x4c, 0x45, 0x43,
+	0x54, 0x49, 0x4e, 0x47, 0x10, 0xca, 0x05, 0x12, 0x2b, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f, 0x57, 0x5f,
+	0x42, 0x41, 0x54, 0x43, 0x48, 0x45, 0x53, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x49, 0x4e,
+	0x47, 0x10, 0xcb, 0x05, 0x12, 0x30, 0x0a, 0x2b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x41, 0x54,
+	0x43, 0x48, 0x45, 0x53, 0x5f, 0x52, 0x45, 0x50, 0x41, 0x52, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e,
+	0x49, 0x4e, 0x47, 0x10, 0xcc, 0x05, 0x12, 0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42,
+	0x55, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4e,
+	0x47, 0x10, 0xcd, 0x05, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x55, 0x43,
+	0x4b, 0x45, 0x54, 0x53, 0x5f, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4e, 0x47, 0x10, 0xce, 0x05,
+	0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48,
+	0x41, 0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x53,
+	0x5f, 0x52, 0x45, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x10, 0xcf, 0x05, 0x12,
+	0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f,
+	0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10,
+	0xd0, 0x05, 0x12, 0x29, 0x0a, 0x24, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0xd1, 0x05, 0x12, 0x1b, 0x0a,
+	0x16, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x51, 0x5f, 0x49,
+	0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10, 0xd2, 0x05, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x51, 0x5f, 0x50, 0x55, 0x54, 0x5f,
+	0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x10, 0xd3, 0x05, 0x12, 0x1a, 0x0a, 0x15, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x51, 0x5f, 0x52, 0x45, 0x43, 0x45,
+	0x49, 0x56, 0x45, 0x10, 0xd4, 0x05, 0x12, 0x17, 0x0a, 0x12, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x51, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x10, 0xd5, 0x05, 0x12,
+	0x24, 0x0a, 0x1f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x41,
+	0x52, 0x41, 0x4c, 0x4c, 0x45, 0x4c, 0x5f, 0x42, 0x49, 0x54, 0x4d, 0x41, 0x50, 0x5f, 0x53, 0x43,
+	0x41, 0x4e, 0x10, 0xd6, 0x05, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4c, 0x4c, 0x45, 0x4c, 0x5f, 0x43, 0x52, 0x45,
+	0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0xd7,
+	0x05, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x50, 0x41, 0x52, 0x41, 0x4c, 0x4c, 0x45, 0x4c, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x10,
+	0xd8, 0x05, 0x12, 0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x50, 0x52, 0x4f, 0x43, 0x41, 0x52, 0x52, 0x41, 0x59, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50,
+	0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0xd9, 0x05, 0x12, 0x17, 0x0a, 0x12, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x45,
+	0x10, 0xda, 0x05, 0x12, 0x27, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52,
+	0x49, 0x47, 0x49, 0x4e, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x10, 0xdb, 0x05, 0x12, 0x25, 0x0a, 0x20,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49,
+	0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x44, 0x52, 0x4f, 0x50,
+	0x10, 0xdc, 0x05, 0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x53, 0x41, 0x46, 0x45, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x10,
+	0xdd, 0x05, 0x12, 0x18, 0x0a, 0x13, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x52, 0x45, 0x50, 0x10, 0xde, 0x05, 0x12, 0x1f, 0x0a, 0x1a,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b,
+	0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0xdf, 0x05, 0x12, 0x20, 0x0a,
+	0x1b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43,
+	0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0xe0, 0x05, 0x12,
+	0x24, 0x0a, 0x1f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x41,
+	0x53, 0x45, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x5f, 0x54, 0x48, 0x52, 0x4f, 0x54, 0x54,
+	0x4c, 0x45, 0x10, 0xa0, 0x06, 0x12, 0x18, 0x0a, 0x13, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x50, 0x47, 0x5f, 0x53, 0x4c, 0x45, 0x45, 0x50, 0x10, 0xa1, 0x06, 0x12,
+	0x24, 0x0a, 0x1f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45,
+	0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x59, 0x5f, 0x44, 0x45, 0x4c,
+	0x41, 0x59, 0x10, 0xa2, 0x06, 0x12, 0x1c, 0x0a, 0x17, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44,
+	0x10, 0x84, 0x07, 0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10,
+	0x85, 0x07, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x52, 0x45,
+	0x41, 0x44, 0x10, 0x86, 0x07, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x45,
+	0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x87, 0x07, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x46,
+	0x49, 0x4c, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10,
+	0x88, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x57, 0x52,
+	0x49, 0x54, 0x45, 0x10, 0x89, 0x07, 0x12, 0x29, 0x0a, 0x24, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4c,
+	0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x8a,
+	0x07, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x43, 0x4f, 0x50, 0x59, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x8b,
+	0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x43, 0x4f, 0x50, 0x59, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10,
+	0x8c, 0x07, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x45, 0x58, 0x54, 0x45, 0x4e,
+	0x44, 0x10, 0x8d, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x46, 0x4c, 0x55,
+	0x53, 0x48, 0x10, 0x8e, 0x07, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x49, 0x4d,
+	0x4d, 0x45, 0x44, 0x49, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x8f, 0x07, 0x12,
+	0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41,
+	0x54, 0x41, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x46, 0x45, 0x54, 0x43, 0x48,
+	0x10, 0x90, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44,
+	0x10, 0x91, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43,
+	0x10, 0x92, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x54, 0x52, 0x55, 0x4e,
+	0x43, 0x41, 0x54, 0x45, 0x10, 0x93, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f,
 	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f,
-	0x50, 0x52, 0x45, 0x46, 0x45, 0x54, 0x43, 0x48, 0x10, 0x90, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46,
-	0x49, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x91, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46,
-	0x49, 0x4c, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x92, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46,
-	0x49, 0x4c, 0x45, 0x5f, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54, 0x45, 0x10, 0x93, 0x07, 0x12,
-	0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41,
-	0x54, 0x41, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x94, 0x07,
-	0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44,
-	0x53, 0x4d, 0x5f, 0x46, 0x49, 0x4c, 0x4c, 0x5f, 0x5a, 0x45, 0x52, 0x4f, 0x5f, 0x57, 0x52, 0x49,
-	0x54, 0x45, 0x10, 0x95, 0x07, 0x12, 0x2b, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x41, 0x44,
-	0x44, 0x54, 0x4f, 0x44, 0x41, 0x54, 0x41, 0x44, 0x49, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10,
-	0x96, 0x07, 0x12, 0x2b, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x54, 0x4f,
-	0x44, 0x41, 0x54, 0x41, 0x44, 0x49, 0x52, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x97, 0x07, 0x12,
-	0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f,
-	0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x54, 0x4f, 0x44, 0x41, 0x54,
-	0x41, 0x44, 0x49, 0x52, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x98, 0x07, 0x12, 0x25, 0x0a,
-	0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b,
-	0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x41,
-	0x44, 0x10, 0x99, 0x07, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x43, 0x52, 0x45,
-	0x41, 0x54, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x9a, 0x07, 0x12, 0x26, 0x0a, 0x21, 0x57,
+	0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x94, 0x07, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x53, 0x4d, 0x5f, 0x46, 0x49, 0x4c, 0x4c, 0x5f,
+	0x5a, 0x45, 0x52, 0x4f, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x95, 0x07, 0x12, 0x2b, 0x0a,
+	0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b,
+	0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x54, 0x4f, 0x44, 0x41, 0x54, 0x41, 0x44,
+	0x49, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x96, 0x07, 0x12, 0x2b, 0x0a, 0x26, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x49,
+	0x4c, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x54, 0x4f, 0x44, 0x41, 0x54, 0x41, 0x44, 0x49, 0x52, 0x5f,
+	0x53, 0x59, 0x4e, 0x43, 0x10, 0x97, 0x07, 0x12, 0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f,
+	0x41, 0x44, 0x44, 0x54, 0x4f, 0x44, 0x41, 0x54, 0x41, 0x44, 0x49, 0x52, 0x5f, 0x57, 0x52, 0x49,
+	0x54, 0x45, 0x10, 0x98, 0x07, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x43, 0x52,
+	0x45, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x99, 0x07, 0x12, 0x25, 0x0a, 0x20,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
+	0x46, 0x49, 0x4c, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43,
+	0x10, 0x9a, 0x07, 0x12, 0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41,
+	0x54, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x9b, 0x07, 0x12, 0x2d, 0x0a, 0x28, 0x57,
 	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46,
-	0x49, 0x4c, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45,
-	0x10, 0x9b, 0x07, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x48,
-	0x45, 0x43, 0x4b, 0x44, 0x41, 0x54, 0x41, 0x44, 0x49, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10,
-	0x9c, 0x07, 0x12, 0x2f, 0x0a, 0x2a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x57, 0x52, 0x49, 0x54, 0x45,
-	0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x53, 0x59, 0x4e, 0x43,
-	0x10, 0x9d, 0x07, 0x12, 0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x57, 0x52, 0x49, 0x54,
-	0x45, 0x5f, 0x4d, 0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x9e,
-	0x07, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f,
-	0x4d, 0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x9f, 0x07,
-	0x12, 0x24, 0x0a, 0x1f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
-	0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x53,
-	0x59, 0x4e, 0x43, 0x10, 0xa0, 0x07, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x57,
-	0x52, 0x49, 0x54, 0x45, 0x5f, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54, 0x45, 0x10, 0xa1, 0x07,
-	0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
-	0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x57,
-	0x52, 0x49, 0x54, 0x45, 0x10, 0xa2, 0x07, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d,
-	0x41, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xa3, 0x07, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x4d, 0x41, 0x50, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xa4, 0x07, 0x12, 0x22, 0x0a,
-	0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4c, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x50, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xa5,
-	0x07, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x52, 0x45, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x52,
-	0x45, 0x41, 0x44, 0x10, 0xa6, 0x07, 0x12, 0x24, 0x0a, 0x1f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x42, 0x55, 0x46,
-	0x46, 0x45, 0x52, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xa7, 0x07, 0x12, 0x2c, 0x0a, 0x27,
-	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4f, 0x52, 0x44,
-	0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x50, 0x50, 0x49,
-	0x4e, 0x47, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xa8, 0x07, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xa9,
-	0x07, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54,
-	0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xaa, 0x07,
-	0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52,
-	0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x5f,
-	0x53, 0x59, 0x4e, 0x43, 0x10, 0xab, 0x07, 0x12, 0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xac, 0x07, 0x12,
-	0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4c,
-	0x52, 0x55, 0x5f, 0x46, 0x4c, 0x55, 0x53, 0x48, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xad, 0x07,
-	0x12, 0x19, 0x0a, 0x14, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53,
-	0x4c, 0x52, 0x55, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xae, 0x07, 0x12, 0x19, 0x0a, 0x14, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4c, 0x52, 0x55, 0x5f, 0x53,
-	0x59, 0x4e, 0x43, 0x10, 0xaf, 0x07, 0x12, 0x1a, 0x0a, 0x15, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4c, 0x52, 0x55, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10,
-	0xb0, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x53, 0x4e, 0x41, 0x50, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10,
-	0xb1, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x53, 0x4e, 0x41, 0x50, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10,
-	0xb2, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x53, 0x4e, 0x41, 0x50, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45,
-	0x10, 0xb3, 0x07, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f,
-	0x52, 0x59, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xb4, 0x07, 0x12,
-	0x2b, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x49,
-	0x4d, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x46,
-	0x49, 0x4c, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xb5, 0x07, 0x12, 0x25, 0x0a, 0x20,
-	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4c,
-	0x49, 0x4e, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x41, 0x44,
-	0x10, 0xb6, 0x07, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f,
-	0x52, 0x59, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xb7, 0x07, 0x12, 0x26, 0x0a, 0x21, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4c, 0x49, 0x4e,
-	0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10,
-	0xb8, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x54, 0x57, 0x4f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x52,
-	0x45, 0x41, 0x44, 0x10, 0xb9, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x57, 0x4f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x46, 0x49,
-	0x4c, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xba, 0x07, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41,
+	0x49, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x44, 0x41, 0x54, 0x41, 0x44,
+	0x49, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x9c, 0x07, 0x12, 0x2f, 0x0a, 0x2a, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c,
+	0x5f, 0x52, 0x45, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f,
+	0x49, 0x4e, 0x54, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x9d, 0x07, 0x12, 0x2c, 0x0a, 0x27, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41,
+	0x4c, 0x5f, 0x52, 0x45, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x4d, 0x41, 0x50, 0x50, 0x49, 0x4e,
+	0x47, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x9e, 0x07, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f,
+	0x52, 0x45, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x4d, 0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x5f,
+	0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x9f, 0x07, 0x12, 0x24, 0x0a, 0x1f, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52,
+	0x45, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xa0, 0x07, 0x12, 0x28,
+	0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47,
+	0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x54, 0x52, 0x55,
+	0x4e, 0x43, 0x41, 0x54, 0x45, 0x10, 0xa1, 0x07, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52,
+	0x45, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xa2, 0x07, 0x12,
+	0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45,
+	0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10,
+	0xa3, 0x07, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x50, 0x5f, 0x53, 0x59,
+	0x4e, 0x43, 0x10, 0xa4, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x50,
+	0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xa5, 0x07, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
+	0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xa6, 0x07, 0x12, 0x24,
+	0x0a, 0x1f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4f,
+	0x52, 0x44, 0x45, 0x52, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x57, 0x52, 0x49, 0x54,
+	0x45, 0x10, 0xa7, 0x07, 0x12, 0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43,
+	0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10,
+	0xa8, 0x07, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f,
+	0x54, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xa9, 0x07, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45,
+	0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xaa, 0x07, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xab, 0x07, 0x12,
+	0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45,
+	0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x57,
+	0x52, 0x49, 0x54, 0x45, 0x10, 0xac, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4c, 0x52, 0x55, 0x5f, 0x46, 0x4c, 0x55, 0x53, 0x48,
+	0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xad, 0x07, 0x12, 0x19, 0x0a, 0x14, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4c, 0x52, 0x55, 0x5f, 0x52, 0x45, 0x41, 0x44,
+	0x10, 0xae, 0x07, 0x12, 0x19, 0x0a, 0x14, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x53, 0x4c, 0x52, 0x55, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xaf, 0x07, 0x12, 0x1a,
+	0x0a, 0x15, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4c, 0x52,
+	0x55, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xb0, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x42, 0x55, 0x49,
+	0x4c, 0x44, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xb1, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x42, 0x55, 0x49,
+	0x4c, 0x44, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xb2, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x42, 0x55, 0x49,
+	0x4c, 0x44, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xb3, 0x07, 0x12, 0x2a, 0x0a, 0x25, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4c, 0x49,
+	0x4e, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f,
+	0x53, 0x59, 0x4e, 0x43, 0x10, 0xb4, 0x07, 0x12, 0x2b, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x48,
+	0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54,
+	0x45, 0x10, 0xb5, 0x07, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54,
+	0x4f, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xb6, 0x07, 0x12, 0x25, 0x0a, 0x20, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4c, 0x49,
+	0x4e, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10,
+	0xb7, 0x07, 0x12, 0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52,
+	0x59, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xb8, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41,
 	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x57, 0x4f, 0x50, 0x48, 0x41, 0x53,
-	0x45, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xbb, 0x07, 0x12,
-	0x2f, 0x0a, 0x2a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41,
-	0x4c, 0x53, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4c, 0x49, 0x4e, 0x45,
-	0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xbc, 0x07,
-	0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57,
-	0x41, 0x4c, 0x5f, 0x42, 0x4f, 0x4f, 0x54, 0x53, 0x54, 0x52, 0x41, 0x50, 0x5f, 0x53, 0x59, 0x4e,
-	0x43, 0x10, 0xbd, 0x07, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x42, 0x4f, 0x4f, 0x54, 0x53, 0x54, 0x52, 0x41, 0x50,
-	0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xbe, 0x07, 0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x50, 0x59,
-	0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xbf, 0x07, 0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x50, 0x59, 0x5f,
-	0x53, 0x59, 0x4e, 0x43, 0x10, 0xc0, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x50, 0x59, 0x5f, 0x57,
-	0x52, 0x49, 0x54, 0x45, 0x10, 0xc1, 0x07, 0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x53,
-	0x59, 0x4e, 0x43, 0x10, 0xc2, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x57, 0x52,
-	0x49, 0x54, 0x45, 0x10, 0xc3, 0x07, 0x12, 0x18, 0x0a, 0x13, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xc4, 0x07,
-	0x12, 0x18, 0x0a, 0x13, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57,
-	0x41, 0x4c, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xc5, 0x07, 0x12, 0x26, 0x0a, 0x21, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x53, 0x59, 0x4e,
-	0x43, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x10,
-	0xc6, 0x07, 0x12, 0x19, 0x0a, 0x14, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xc7, 0x07, 0x12, 0x23, 0x0a,
-	0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x43,
-	0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x42, 0x41, 0x52, 0x52, 0x49, 0x45, 0x52, 0x10,
-	0xc8, 0x07, 0x12, 0x1c, 0x0a, 0x17, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x49, 0x4f, 0x5f, 0x58, 0x41, 0x43, 0x54, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x90, 0x4e,
-	0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41,
-	0x55, 0x52, 0x4f, 0x52, 0x41, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49,
-	0x4e, 0x10, 0x91, 0x4e, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x41, 0x55, 0x52, 0x4f, 0x52, 0x41, 0x5f, 0x52, 0x55, 0x4e, 0x54, 0x49, 0x4d,
-	0x45, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0x92, 0x4e, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x49, 0x54, 0x55, 0x53, 0x5f, 0x51, 0x55,
-	0x45, 0x52, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x10, 0x93, 0x4e, 0x22, 0x04, 0x08, 0x64,
-	0x10, 0x64, 0x22, 0xc1, 0x02, 0x0a, 0x19, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x50, 0x72, 0x6f,
-	0x67, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x76, 0x61, 0x63, 0x75, 0x75,
-	0x6d, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6c,
-	0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x6f, 0x6c,
-	0x65, 0x49, 0x64, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
-	0x5f, 0x69, 0x64, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61,
-	0x62, 0x61, 0x73, 0x65, 0x49, 0x64, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72,
-	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x78, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x61,
-	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x61, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x05, 0x74, 0x6f, 0x61, 0x73, 0x74, 0x22, 0x9a, 0x04, 0x0a, 0x17, 0x56, 0x61, 0x63, 0x75, 0x75,
-	0x6d, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
-	0x69, 0x63, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x5f, 0x69, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x76, 0x61, 0x63,
-	0x75, 0x75, 0x6d, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x4e, 0x0a, 0x05, 0x70,
-	0x68, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x38, 0x2e, 0x70, 0x67, 0x61,
-	0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x2e, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x2e, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x50,
-	0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x68,
-	0x65, 0x61, 0x70, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x68, 0x65, 0x61, 0x70, 0x42, 0x6c, 0x6b, 0x73, 0x54, 0x6f,
-	0x74, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x68, 0x65, 0x61, 0x70, 0x5f, 0x62, 0x6c, 0x6b, 0x73,
-	0x5f, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
-	0x68, 0x65, 0x61, 0x70, 0x42, 0x6c, 0x6b, 0x73, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12,
-	0x2c, 0x0a, 0x12, 0x68, 0x65, 0x61, 0x70, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x76, 0x61, 0x63,
-	0x75, 0x75, 0x6d, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x68, 0x65, 0x61,
-	0x70, 0x42, 0x6c, 0x6b, 0x73, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x65, 0x64, 0x12, 0x2c, 0x0a,
-	0x12, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x5f, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d,
-	0x61, 0x78, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x61, 0x64, 0x54, 0x75, 0x70,
-	0x6c, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x5f,
-	0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x75,
-	0x6d, 0x44, 0x65, 0x61, 0x64, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x0b,
-	0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x49,
-	0x4e, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0d, 0x0a,
-	0x09, 0x53, 0x43, 0x41, 0x4e, 0x5f, 0x48, 0x45, 0x41, 0x50, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c,
-	0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0x02, 0x12, 0x0f,
-	0x0a, 0x0b, 0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f, 0x48, 0x45, 0x41, 0x50, 0x10, 0x03, 0x12,
-	0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x4e, 0x55, 0x50,
-	0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54, 0x45, 0x10, 0x05,
-	0x12, 0x11, 0x0a, 0x0d, 0x46, 0x49, 0x4e, 0x41, 0x4c, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x4e, 0x55,
-	0x50, 0x10, 0x06, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x45, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xb9, 0x07, 0x12, 0x22,
+	0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x57, 0x4f,
+	0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10,
+	0xba, 0x07, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x54, 0x57, 0x4f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x57,
+	0x52, 0x49, 0x54, 0x45, 0x10, 0xbb, 0x07, 0x12, 0x2f, 0x0a, 0x2a, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x53, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f,
+	0x54, 0x49, 0x4d, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59,
+	0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xbc, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x42, 0x4f, 0x4f, 0x54, 0x53,
+	0x54, 0x52, 0x41, 0x50, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xbd, 0x07, 0x12, 0x23, 0x0a, 0x1e,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x42,
+	0x4f, 0x4f, 0x54, 0x53, 0x54, 0x52, 0x41, 0x50, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xbe,
+	0x07, 0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x57, 0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x50, 0x59, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xbf, 0x07,
+	0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57,
+	0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x50, 0x59, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xc0, 0x07, 0x12,
+	0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41,
+	0x4c, 0x5f, 0x43, 0x4f, 0x50, 0x59, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xc1, 0x07, 0x12,
+	0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41,
+	0x4c, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xc2, 0x07, 0x12, 0x1e,
+	0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c,
+	0x5f, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xc3, 0x07, 0x12, 0x18,
+	0x0a, 0x13, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c,
+	0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xc4, 0x07, 0x12, 0x18, 0x0a, 0x13, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10,
+	0xc5, 0x07, 0x12, 0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44,
+	0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x10, 0xc6, 0x07, 0x12, 0x19, 0x0a, 0x14, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x57, 0x52, 0x49,
+	0x54, 0x45, 0x10, 0xc7, 0x07, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f,
+	0x42, 0x41, 0x52, 0x52, 0x49, 0x45, 0x52, 0x10, 0xc8, 0x07, 0x12, 0x1c, 0x0a, 0x17, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x4f, 0x5f, 0x58, 0x41, 0x43, 0x54,
+	0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x90, 0x4e, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x55, 0x52, 0x4f, 0x52, 0x41, 0x5f, 0x52, 0x45,
+	0x41, 0x44, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0x91, 0x4e, 0x12, 0x23, 0x0a, 0x1e,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x55, 0x52, 0x4f, 0x52,
+	0x41, 0x5f, 0x52, 0x55, 0x4e, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0x92,
+	0x4e, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x43, 0x49, 0x54, 0x55, 0x53, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x53, 0x10, 0x93, 0x4e, 0x22, 0x04, 0x08, 0x64, 0x10, 0x64, 0x22, 0xc1, 0x02, 0x0a, 0x19, 0x56,
+	0x61, 0x63, 0x75, 0x75, 0x6d, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x63, 0x75,
+	0x75, 0x6d, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x78, 0x12, 0x21, 0x0a, 0x0c,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x64, 0x78, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x78, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x78, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x39, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x6f,
+	0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x75,
+	0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x61, 0x73,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x74, 0x6f, 0x61, 0x73, 0x74, 0x22, 0x9a,
+	0x04, 0x0a, 0x17, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61,
+	0x63, 0x75, 0x75, 0x6d, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x4e, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x38, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x2e, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68,
+	0x61, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x68, 0x65, 0x61, 0x70, 0x5f, 0x62, 0x6c, 0x6b, 0x73,
+	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x68, 0x65,
+	0x61, 0x70, 0x42, 0x6c, 0x6b, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x68,
+	0x65, 0x61, 0x70, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x68, 0x65, 0x61, 0x70, 0x42, 0x6c, 0x6b, 0x73,
+	0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x68, 0x65, 0x61, 0x70, 0x5f,
+	0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x68, 0x65, 0x61, 0x70, 0x42, 0x6c, 0x6b, 0x73, 0x56, 0x61, 0x63,
+	0x75, 0x75, 0x6d, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x76,
+	0x61, 0x63, 0x75, 0x75, 0x6d, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x10, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x5f,
+	0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x61,
+	0x78, 0x44, 0x65, 0x61, 0x64, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
+	0x75, 0x6d, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x44, 0x65, 0x61, 0x64, 0x54, 0x75, 0x70,
+	0x6c, 0x65, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x0b, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x50, 0x68,
+	0x61, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x49, 0x5a,
+	0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x43, 0x41, 0x4e, 0x5f, 0x48, 0x45,
+	0x41, 0x50, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f, 0x49,
+	0x4e, 0x44, 0x45, 0x58, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x56, 0x41, 0x43, 0x55, 0x55, 0x4d,
+	0x5f, 0x48, 0x45, 0x41, 0x50, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x44, 0x45, 0x58,
+	0x5f, 0x43, 0x4c, 0x45, 0x41, 0x4e, 0x55, 0x50, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x52,
+	0x55, 0x4e, 0x43, 0x41, 0x54, 0x45, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x49, 0x4e, 0x41,
+	0x4c, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x4e, 0x55, 0x50, 0x10, 0x06, 0x22, 0xfa, 0x03, 0x0a, 0x13,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x64,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x64, 0x78, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x78, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x62, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x4a, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61,
+	0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x5d, 0x0a, 0x07, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
+	0x00, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x44, 0x45,
+	0x58, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x5a, 0x45, 0x10, 0x02,
+	0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x10, 0x03, 0x12, 0x08, 0x0a,
+	0x04, 0x43, 0x4f, 0x50, 0x59, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x41, 0x53, 0x45, 0x5f,
+	0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x10, 0x05, 0x22, 0xdd, 0x03, 0x0a, 0x11, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x2b,
+	0x0a, 0x11, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x68, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73,
+	0x65, 0x12, 0x46, 0x0a, 0x11, 0x70, 0x68, 0x61, 0x73, 0x65, 0x5f, 0x6f, 0x62, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x70, 0x68, 0x61, 0x73, 0x65, 0x4f,
+	0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x44, 0x6f, 0x6e, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x5f, 0x64, 0x6f, 0x6e, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x44, 0x6f, 0x6e,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x64, 0x6f, 0x6e, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x79, 0x74, 0x65, 0x73, 0x44, 0x6f, 0x6e,
+	0x65, 0x12, 0x3d, 0x0a, 0x1b, 0x68, 0x61, 0x73, 0x5f, 0x70, 0x68, 0x61, 0x73, 0x65, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x68, 0x61, 0x73, 0x50, 0x68, 0x61, 0x73, 0x65,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x12, 0x36, 0x0a, 0x17, 0x70, 0x68, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x15, 0x70, 0x68, 0x61, 0x73, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x52,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2076,36 +2464,44 @@ func file_compact_activity_snapshot_proto_rawDescGZIP() []byte {
 	return file_compact_activity_snapshot_proto_rawDescData
 }
 
-var file_compact_activity_snapshot_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_compact_activity_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_compact_activity_snapshot_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_compact_activity_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_compact_activity_snapshot_proto_goTypes = []interface{}{
 	(Backend_WaitEventType)(0),               // 0: pganalyze.collector.Backend.WaitEventType
 	(Backend_WaitEvent)(0),                   // 1: pganalyze.collector.Backend.WaitEvent
 	(VacuumProgressStatistic_VacuumPhase)(0), // 2: pganalyze.collector.VacuumProgressStatistic.VacuumPhase
-	(*CompactActivitySnapshot)(nil),          // 3: pganalyze.collector.CompactActivitySnapshot
-	(*Backend)(nil),                          // 4: pganalyze.collector.Backend
-	(*VacuumProgressInformation)(nil),        // 5: pganalyze.collector.VacuumProgressInformation
-	(*VacuumProgressStatistic)(nil),          // 6: pganalyze.collector.VacuumProgressStatistic
-	(*PostgresVersion)(nil),                  // 7: pganalyze.collector.PostgresVersion
-	(*timestamp.Timestamp)(nil),              // 8: google.protobuf.Timestamp
+	(ProgressInformation_Command)(0),         // 3: pganalyze.collector.ProgressInformation.Command
+	(*CompactActivitySnapshot)(nil),          // 4: pganalyze.collector.CompactActivitySnapshot
+	(*Backend)(nil),                          // 5: pganalyze.collector.Backend
+	(*VacuumProgressInformation)(nil),        // 6: pganalyze.collector.VacuumProgressInformation
+	(*VacuumProgressStatistic)(nil),          // 7: pganalyze.collector.VacuumProgressStatistic
+	(*ProgressInformation)(nil),              // 8: pganalyze.collector.ProgressInformation
+	(*ProgressStatistic)(nil),                // 9: pganalyze.collector.ProgressStatistic
+	(*PostgresVersion)(nil),                  // 10: pganalyze.collector.PostgresVersion
+	(*timestamp.Timestamp)(nil),              // 11: google.protobuf.Timestamp
 }
 var file_compact_activity_snapshot_proto_depIdxs = []int32{
-	7,  // 0: pganalyze.collector.CompactActivitySnapshot.postgres_version:type_name -> pganalyze.collector.PostgresVersion
-	4,  // 1: pganalyze.collector.CompactActivitySnapshot.backends:type_name -> pganalyze.collector.Backend
-	8,  // 2: pganalyze.collector.CompactActivitySnapshot.prev_activity_snapshot_at:type_name -> google.protobuf.Timestamp
-	5,  // 3: pganalyze.collector.CompactActivitySnapshot.vacuum_progress_informations:type_name -> pganalyze.collector.VacuumProgressInformation
-	6,  // 4: pganalyze.collector.CompactActivitySnapshot.vacuum_progress_statistics:type_name -> pganalyze.collector.VacuumProgressStatistic
-	8,  // 5: pganalyze.collector.Backend.backend_start:type_name -> google.protobuf.Timestamp
-	8,  // 6: pganalyze.collector.Backend.xact_start:type_name -> google.protobuf.Timestamp
-	8,  // 7: pganalyze.collector.Backend.query_start:type_name -> google.protobuf.Timestamp
-	8,  // 8: pganalyze.collector.Backend.state_change:type_name -> google.protobuf.Timestamp
-	8,  // 9: pganalyze.collector.VacuumProgressInformation.started_at:type_name -> google.protobuf.Timestamp
-	2,  // 10: pganalyze.collector.VacuumProgressStatistic.phase:type_name -> pganalyze.collector.VacuumProgressStatistic.VacuumPhase
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	10, // 0: pganalyze.collector.CompactActivitySnapshot.postgres_version:type_name -> pganalyze.collector.PostgresVersion
+	5,  // 1: pganalyze.collector.CompactActivitySnapshot.backends:type_name -> pganalyze.collector.Backend
+	11, // 2: pganalyze.collector.CompactActivitySnapshot.prev_activity_snapshot_at:type_name -> google.protobuf.Timestamp
+	6,  // 3: pganalyze.collector.CompactActivitySnapshot.vacuum_progress_informations:type_name -> pganalyze.collector.VacuumProgressInformation
+	7,  // 4: pganalyze.collector.CompactActivitySnapshot.vacuum_progress_statistics:type_name -> pganalyze.collector.VacuumProgressStatistic
+	8,  // 5: pganalyze.collector.CompactActivitySnapshot.progress_informations:type_name -> pganalyze.collector.ProgressInformation
+	9,  // 6: pganalyze.collector.CompactActivitySnapshot.progress_statistics:type_name -> pganalyze.collector.ProgressStatistic
+	11, // 7: pganalyze.collector.Backend.backend_start:type_name -> google.protobuf.Timestamp
+	11, // 8: pganalyze.collector.Backend.xact_start:type_name -> google.protobuf.Timestamp
+	11, // 9: pganalyze.collector.Backend.query_start:type_name -> google.protobuf.Timestamp
+	11, // 10: pganalyze.collector.Backend.state_change:type_name -> google.protobuf.Timestamp
+	11, // 11: pganalyze.collector.VacuumProgressInformation.started_at:type_name -> google.protobuf.Timestamp
+	2,  // 12: pganalyze.collector.VacuumProgressStatistic.phase:type_name -> pganalyze.collector.VacuumProgressStatistic.VacuumPhase
+	11, // 13: pganalyze.collector.ProgressInformation.started_at:type_name -> google.protobuf.Timestamp
+	3,  // 14: pganalyze.collector.ProgressInformation.command:type_name -> pganalyze.collector.ProgressInformation.Command
+	11, // 15: pganalyze.collector.ProgressStatistic.phase_observed_at:type_name -> google.protobuf.Timestamp
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_compact_activity_snapshot_proto_init() }
@@ -2163,14 +2559,38 @@ func file_compact_activity_snapshot_proto_init() {
 				return nil
 			}
 		}
+		file_compact_activity_snapshot_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProgressInformation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_compact_activity_snapshot_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProgressStatistic); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_compact_activity_snapshot_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   4,
+			NumEnums:      4,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
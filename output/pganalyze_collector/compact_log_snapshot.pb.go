@@ -983,11 +983,15 @@ type QuerySample struct {
 	Parameters  []string             `protobuf:"bytes,5,rep,name=parameters,proto3" json:"parameters,omitempty"`
 	LogLineUuid string               `protobuf:"bytes,10,opt,name=log_line_uuid,json=logLineUuid,proto3" json:"log_line_uuid,omitempty"`
 	// Note: For historic reasons this contains an inline version of QueryExplainInformation
-	HasExplain    bool                      `protobuf:"varint,20,opt,name=has_explain,json=hasExplain,proto3" json:"has_explain,omitempty"`
-	ExplainOutput string                    `protobuf:"bytes,21,opt,name=explain_output,json=explainOutput,proto3" json:"explain_output,omitempty"`
-	ExplainError  string                    `protobuf:"bytes,22,opt,name=explain_error,json=explainError,proto3" json:"explain_error,omitempty"`
-	ExplainFormat QuerySample_ExplainFormat `protobuf:"varint,23,opt,name=explain_format,json=explainFormat,proto3,enum=pganalyze.collector.QuerySample_ExplainFormat" json:"explain_format,omitempty"`
-	ExplainSource QuerySample_ExplainSource `protobuf:"varint,24,opt,name=explain_source,json=explainSource,proto3,enum=pganalyze.collector.QuerySample_ExplainSource" json:"explain_source,omitempty"`
+	HasExplain              bool                      `protobuf:"varint,20,opt,name=has_explain,json=hasExplain,proto3" json:"has_explain,omitempty"`
+	ExplainOutput           string                    `protobuf:"bytes,21,opt,name=explain_output,json=explainOutput,proto3" json:"explain_output,omitempty"`
+	ExplainError            string                    `protobuf:"bytes,22,opt,name=explain_error,json=explainError,proto3" json:"explain_error,omitempty"`
+	ExplainFormat           QuerySample_ExplainFormat `protobuf:"varint,23,opt,name=explain_format,json=explainFormat,proto3,enum=pganalyze.collector.QuerySample_ExplainFormat" json:"explain_format,omitempty"`
+	ExplainSource           QuerySample_ExplainSource `protobuf:"varint,24,opt,name=explain_source,json=explainSource,proto3,enum=pganalyze.collector.QuerySample_ExplainSource" json:"explain_source,omitempty"`
+	PlanFingerprint         string                    `protobuf:"bytes,30,opt,name=plan_fingerprint,json=planFingerprint,proto3" json:"plan_fingerprint,omitempty"` // Fingerprint of the plan shape (auto_explain JSON plans only), ignoring costs and row estimates
+	PlanNodes               []string                  `protobuf:"bytes,31,rep,name=plan_nodes,json=planNodes,proto3" json:"plan_nodes,omitempty"`                   // One line summary of each plan node, depth-first
+	PlanChanged             bool                      `protobuf:"varint,32,opt,name=plan_changed,json=planChanged,proto3" json:"plan_changed,omitempty"`            // Plan differs from the last plan the collector saw for this query
+	PreviousPlanFingerprint string                    `protobuf:"bytes,33,opt,name=previous_plan_fingerprint,json=previousPlanFingerprint,proto3" json:"previous_plan_fingerprint,omitempty"`
 }
 
 func (x *QuerySample) Reset() {
@@ -1099,6 +1103,34 @@ func (x *QuerySample) GetExplainSource() QuerySample_ExplainSource {
 	return QuerySample_STATEMENT_LOG_EXPLAIN_SOURCE
 }
 
+func (x *QuerySample) GetPlanFingerprint() string {
+	if x != nil {
+		return x.PlanFingerprint
+	}
+	return ""
+}
+
+func (x *QuerySample) GetPlanNodes() []string {
+	if x != nil {
+		return x.PlanNodes
+	}
+	return nil
+}
+
+func (x *QuerySample) GetPlanChanged() bool {
+	if x != nil {
+		return x.PlanChanged
+	}
+	return false
+}
+
+func (x *QuerySample) GetPreviousPlanFingerprint() string {
+	if x != nil {
+		return x.PreviousPlanFingerprint
+	}
+	return ""
+}
+
 var File_compact_log_snapshot_proto protoreflect.FileDescriptor
 
 var file_compact_log_snapshot_proto_rawDesc = []byte{
@@ -1397,7 +1429,7 @@ var file_compact_log_snapshot_proto_rawDesc = []byte{
 	0x41, 0x4c, 0x49, 0x5a, 0x45, 0x5f, 0x53, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x41, 0x42,
 	0x4c, 0x45, 0x10, 0x8b, 0x01, 0x12, 0x1b, 0x0a, 0x16, 0x50, 0x47, 0x41, 0x5f, 0x43, 0x4f, 0x4c,
 	0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x46, 0x59, 0x10,
-	0xe8, 0x07, 0x22, 0xd5, 0x05, 0x0a, 0x0b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x61, 0x6d, 0x70,
+	0xe8, 0x07, 0x22, 0xfe, 0x06, 0x0a, 0x0b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x61, 0x6d, 0x70,
 	0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x78, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x65, 0x72, 0x79, 0x49, 0x64, 0x78, 0x12,
 	0x3b, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02,
@@ -1429,21 +1461,31 @@ var file_compact_log_snapshot_proto_rawDesc = []byte{
 	0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
 	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x45, 0x78, 0x70,
 	0x6c, 0x61, 0x69, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x6c,
-	0x61, 0x69, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x41, 0x0a, 0x0d, 0x45, 0x78, 0x70,
-	0x6c, 0x61, 0x69, 0x6e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x45,
-	0x58, 0x54, 0x5f, 0x45, 0x58, 0x50, 0x4c, 0x41, 0x49, 0x4e, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41,
-	0x54, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x4a, 0x53, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x50, 0x4c,
-	0x41, 0x49, 0x4e, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x10, 0x01, 0x22, 0x8b, 0x01, 0x0a,
-	0x0d, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x20,
-	0x0a, 0x1c, 0x53, 0x54, 0x41, 0x54, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x5f,
-	0x45, 0x58, 0x50, 0x4c, 0x41, 0x49, 0x4e, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x00,
-	0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x45, 0x58, 0x50, 0x4c, 0x41, 0x49, 0x4e,
-	0x5f, 0x45, 0x58, 0x50, 0x4c, 0x41, 0x49, 0x4e, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10,
-	0x01, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x58,
-	0x50, 0x4c, 0x41, 0x49, 0x4e, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x02, 0x12, 0x1a,
-	0x0a, 0x16, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x49, 0x43, 0x5f, 0x45, 0x58, 0x50, 0x4c, 0x41, 0x49,
-	0x4e, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x03, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x61, 0x69, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x6c, 0x61,
+	0x6e, 0x5f, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x1e, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x6c, 0x61, 0x6e, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x69, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x6e, 0x6f, 0x64,
+	0x65, 0x73, 0x18, 0x1f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x6e, 0x4e, 0x6f,
+	0x64, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x18, 0x20, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x6e, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x19, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72,
+	0x69, 0x6e, 0x74, 0x18, 0x21, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x50, 0x6c, 0x61, 0x6e, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69,
+	0x6e, 0x74, 0x22, 0x41, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x45, 0x58, 0x54, 0x5f, 0x45, 0x58, 0x50, 0x4c,
+	0x41, 0x49, 0x4e, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13,
+	0x4a, 0x53, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x50, 0x4c, 0x41, 0x49, 0x4e, 0x5f, 0x46, 0x4f, 0x52,
+	0x4d, 0x41, 0x54, 0x10, 0x01, 0x22, 0x8b, 0x01, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69,
+	0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x5f, 0x45, 0x58, 0x50, 0x4c, 0x41, 0x49, 0x4e,
+	0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x55, 0x54,
+	0x4f, 0x5f, 0x45, 0x58, 0x50, 0x4c, 0x41, 0x49, 0x4e, 0x5f, 0x45, 0x58, 0x50, 0x4c, 0x41, 0x49,
+	0x4e, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x58,
+	0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x58, 0x50, 0x4c, 0x41, 0x49, 0x4e, 0x5f, 0x53,
+	0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x45, 0x4e, 0x45, 0x52,
+	0x49, 0x43, 0x5f, 0x45, 0x58, 0x50, 0x4c, 0x41, 0x49, 0x4e, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43,
+	0x45, 0x10, 0x03, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
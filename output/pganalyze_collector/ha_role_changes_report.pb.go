@@ -0,0 +1,155 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.13.0
+// source: ha_role_changes_report.proto
+
+package pganalyze_collector
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type HARoleChangesReportData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HaRoleChanges []*HARoleChange `protobuf:"bytes,10,rep,name=ha_role_changes,json=haRoleChanges,proto3" json:"ha_role_changes,omitempty"` // Rolling change log, most recent change first
+}
+
+func (x *HARoleChangesReportData) Reset() {
+	*x = HARoleChangesReportData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ha_role_changes_report_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HARoleChangesReportData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HARoleChangesReportData) ProtoMessage() {}
+
+func (x *HARoleChangesReportData) ProtoReflect() protoreflect.Message {
+	mi := &file_ha_role_changes_report_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HARoleChangesReportData.ProtoReflect.Descriptor instead.
+func (*HARoleChangesReportData) Descriptor() ([]byte, []int) {
+	return file_ha_role_changes_report_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HARoleChangesReportData) GetHaRoleChanges() []*HARoleChange {
+	if x != nil {
+		return x.HaRoleChanges
+	}
+	return nil
+}
+
+var File_ha_role_changes_report_proto protoreflect.FileDescriptor
+
+var file_ha_role_changes_report_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x68, 0x61, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13,
+	0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x1a, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x64, 0x0a, 0x17, 0x48, 0x41, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x49, 0x0a, 0x0f,
+	0x68, 0x61, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a,
+	0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x48, 0x41, 0x52, 0x6f,
+	0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0d, 0x68, 0x61, 0x52, 0x6f, 0x6c, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ha_role_changes_report_proto_rawDescOnce sync.Once
+	file_ha_role_changes_report_proto_rawDescData = file_ha_role_changes_report_proto_rawDesc
+)
+
+func file_ha_role_changes_report_proto_rawDescGZIP() []byte {
+	file_ha_role_changes_report_proto_rawDescOnce.Do(func() {
+		file_ha_role_changes_report_proto_rawDescData = protoimpl.X.CompressGZIP(file_ha_role_changes_report_proto_rawDescData)
+	})
+	return file_ha_role_changes_report_proto_rawDescData
+}
+
+var file_ha_role_changes_report_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_ha_role_changes_report_proto_goTypes = []interface{}{
+	(*HARoleChangesReportData)(nil), // 0: pganalyze.collector.HARoleChangesReportData
+	(*HARoleChange)(nil),            // 1: pganalyze.collector.HARoleChange
+}
+var file_ha_role_changes_report_proto_depIdxs = []int32{
+	1, // 0: pganalyze.collector.HARoleChangesReportData.ha_role_changes:type_name -> pganalyze.collector.HARoleChange
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_ha_role_changes_report_proto_init() }
+func file_ha_role_changes_report_proto_init() {
+	if File_ha_role_changes_report_proto != nil {
+		return
+	}
+	file_shared_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_ha_role_changes_report_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HARoleChangesReportData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ha_role_changes_report_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ha_role_changes_report_proto_goTypes,
+		DependencyIndexes: file_ha_role_changes_report_proto_depIdxs,
+		MessageInfos:      file_ha_role_changes_report_proto_msgTypes,
+	}.Build()
+	File_ha_role_changes_report_proto = out.File
+	file_ha_role_changes_report_proto_rawDesc = nil
+	file_ha_role_changes_report_proto_goTypes = nil
+	file_ha_role_changes_report_proto_depIdxs = nil
+}
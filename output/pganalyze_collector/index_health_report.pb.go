@@ -0,0 +1,485 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.13.0
+// source: index_health_report.proto
+
+package pganalyze_collector
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type IndexHealthIssue_Issue int32
+
+const (
+	IndexHealthIssue_UNKNOWN                IndexHealthIssue_Issue = 0
+	IndexHealthIssue_INVALID                IndexHealthIssue_Issue = 1
+	IndexHealthIssue_DUPLICATE              IndexHealthIssue_Issue = 2
+	IndexHealthIssue_COVERED_BY_PRIMARY_KEY IndexHealthIssue_Issue = 3
+	IndexHealthIssue_PREFIX_DUPLICATE       IndexHealthIssue_Issue = 4
+	IndexHealthIssue_UNUSED                 IndexHealthIssue_Issue = 5
+)
+
+// Enum value maps for IndexHealthIssue_Issue.
+var (
+	IndexHealthIssue_Issue_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "INVALID",
+		2: "DUPLICATE",
+		3: "COVERED_BY_PRIMARY_KEY",
+		4: "PREFIX_DUPLICATE",
+		5: "UNUSED",
+	}
+	IndexHealthIssue_Issue_value = map[string]int32{
+		"UNKNOWN":                0,
+		"INVALID":                1,
+		"DUPLICATE":              2,
+		"COVERED_BY_PRIMARY_KEY": 3,
+		"PREFIX_DUPLICATE":       4,
+		"UNUSED":                 5,
+	}
+)
+
+func (x IndexHealthIssue_Issue) Enum() *IndexHealthIssue_Issue {
+	p := new(IndexHealthIssue_Issue)
+	*p = x
+	return p
+}
+
+func (x IndexHealthIssue_Issue) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IndexHealthIssue_Issue) Descriptor() protoreflect.EnumDescriptor {
+	return file_index_health_report_proto_enumTypes[0].Descriptor()
+}
+
+func (IndexHealthIssue_Issue) Type() protoreflect.EnumType {
+	return &file_index_health_report_proto_enumTypes[0]
+}
+
+func (x IndexHealthIssue_Issue) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IndexHealthIssue_Issue.Descriptor instead.
+func (IndexHealthIssue_Issue) EnumDescriptor() ([]byte, []int) {
+	return file_index_health_report_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type IndexHealthReportData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DatabaseReferences   []*DatabaseReference              `protobuf:"bytes,10,rep,name=database_references,json=databaseReferences,proto3" json:"database_references,omitempty"`
+	RelationReferences   []*RelationReference              `protobuf:"bytes,11,rep,name=relation_references,json=relationReferences,proto3" json:"relation_references,omitempty"`
+	DatabaseInformations []*IndexHealthDatabaseInformation `protobuf:"bytes,20,rep,name=database_informations,json=databaseInformations,proto3" json:"database_informations,omitempty"`
+	Issues               []*IndexHealthIssue               `protobuf:"bytes,21,rep,name=issues,proto3" json:"issues,omitempty"`
+}
+
+func (x *IndexHealthReportData) Reset() {
+	*x = IndexHealthReportData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_index_health_report_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IndexHealthReportData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexHealthReportData) ProtoMessage() {}
+
+func (x *IndexHealthReportData) ProtoReflect() protoreflect.Message {
+	mi := &file_index_health_report_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexHealthReportData.ProtoReflect.Descriptor instead.
+func (*IndexHealthReportData) Descriptor() ([]byte, []int) {
+	return file_index_health_report_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *IndexHealthReportData) GetDatabaseReferences() []*DatabaseReference {
+	if x != nil {
+		return x.DatabaseReferences
+	}
+	return nil
+}
+
+func (x *IndexHealthReportData) GetRelationReferences() []*RelationReference {
+	if x != nil {
+		return x.RelationReferences
+	}
+	return nil
+}
+
+func (x *IndexHealthReportData) GetDatabaseInformations() []*IndexHealthDatabaseInformation {
+	if x != nil {
+		return x.DatabaseInformations
+	}
+	return nil
+}
+
+func (x *IndexHealthReportData) GetIssues() []*IndexHealthIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+type IndexHealthDatabaseInformation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DatabaseIdx   int32          `protobuf:"varint,1,opt,name=database_idx,json=databaseIdx,proto3" json:"database_idx,omitempty"`
+	StatsResetAt  *NullTimestamp `protobuf:"bytes,2,opt,name=stats_reset_at,json=statsResetAt,proto3" json:"stats_reset_at,omitempty"`   // When statistics of this database were last reset
+	CheckedUnused bool           `protobuf:"varint,3,opt,name=checked_unused,json=checkedUnused,proto3" json:"checked_unused,omitempty"` // False if statistics were reset too recently to determine unused indexes
+}
+
+func (x *IndexHealthDatabaseInformation) Reset() {
+	*x = IndexHealthDatabaseInformation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_index_health_report_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IndexHealthDatabaseInformation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexHealthDatabaseInformation) ProtoMessage() {}
+
+func (x *IndexHealthDatabaseInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_index_health_report_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexHealthDatabaseInformation.ProtoReflect.Descriptor instead.
+func (*IndexHealthDatabaseInformation) Descriptor() ([]byte, []int) {
+	return file_index_health_report_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IndexHealthDatabaseInformation) GetDatabaseIdx() int32 {
+	if x != nil {
+		return x.DatabaseIdx
+	}
+	return 0
+}
+
+func (x *IndexHealthDatabaseInformation) GetStatsResetAt() *NullTimestamp {
+	if x != nil {
+		return x.StatsResetAt
+	}
+	return nil
+}
+
+func (x *IndexHealthDatabaseInformation) GetCheckedUnused() bool {
+	if x != nil {
+		return x.CheckedUnused
+	}
+	return false
+}
+
+// Index that is invalid, redundant or unused (each index is only reported once)
+type IndexHealthIssue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RelationIdx int32                  `protobuf:"varint,1,opt,name=relation_idx,json=relationIdx,proto3" json:"relation_idx,omitempty"`
+	IndexName   string                 `protobuf:"bytes,2,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	IndexDef    string                 `protobuf:"bytes,3,opt,name=index_def,json=indexDef,proto3" json:"index_def,omitempty"`
+	Issue       IndexHealthIssue_Issue `protobuf:"varint,4,opt,name=issue,proto3,enum=pganalyze.collector.IndexHealthIssue_Issue" json:"issue,omitempty"`
+	SizeBytes   int64                  `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	IdxScan     int64                  `protobuf:"varint,6,opt,name=idx_scan,json=idxScan,proto3" json:"idx_scan,omitempty"`      // Number of index scans since statistics were last reset
+	CoveredBy   string                 `protobuf:"bytes,7,opt,name=covered_by,json=coveredBy,proto3" json:"covered_by,omitempty"` // For duplicates, the name of the index that makes this index redundant
+}
+
+func (x *IndexHealthIssue) Reset() {
+	*x = IndexHealthIssue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_index_health_report_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IndexHealthIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexHealthIssue) ProtoMessage() {}
+
+func (x *IndexHealthIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_index_health_report_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexHealthIssue.ProtoReflect.Descriptor instead.
+func (*IndexHealthIssue) Descriptor() ([]byte, []int) {
+	return file_index_health_report_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *IndexHealthIssue) GetRelationIdx() int32 {
+	if x != nil {
+		return x.RelationIdx
+	}
+	return 0
+}
+
+func (x *IndexHealthIssue) GetIndexName() string {
+	if x != nil {
+		return x.IndexName
+	}
+	return ""
+}
+
+func (x *IndexHealthIssue) GetIndexDef() string {
+	if x != nil {
+		return x.IndexDef
+	}
+	return ""
+}
+
+func (x *IndexHealthIssue) GetIssue() IndexHealthIssue_Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return IndexHealthIssue_UNKNOWN
+}
+
+func (x *IndexHealthIssue) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *IndexHealthIssue) GetIdxScan() int64 {
+	if x != nil {
+		return x.IdxScan
+	}
+	return 0
+}
+
+func (x *IndexHealthIssue) GetCoveredBy() string {
+	if x != nil {
+		return x.CoveredBy
+	}
+	return ""
+}
+
+var File_index_health_report_proto protoreflect.FileDescriptor
+
+var file_index_health_report_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x70, 0x67, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x1a, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf2,
+	0x02, 0x0a, 0x15, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x57, 0x0a, 0x13, 0x64, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a,
+	0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x12, 0x64,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x12, 0x57, 0x0a, 0x13, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x12, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x68, 0x0a, 0x15, 0x64, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x70, 0x67, 0x61, 0x6e,
+	0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x14,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3d, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x15,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65,
+	0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x06, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x1e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x64, 0x78, 0x12, 0x48, 0x0a, 0x0e, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x41, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x75,
+	0x6e, 0x75, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x65, 0x64, 0x55, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x22, 0xfd, 0x02, 0x0a, 0x10, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x49, 0x73, 0x73, 0x75, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x65, 0x66, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x44, 0x65, 0x66, 0x12, 0x41,
+	0x0a, 0x05, 0x69, 0x73, 0x73, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e,
+	0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x49,
+	0x73, 0x73, 0x75, 0x65, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x05, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x19, 0x0a, 0x08, 0x69, 0x64, 0x78, 0x5f, 0x73, 0x63, 0x61, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x69, 0x64, 0x78, 0x53, 0x63, 0x61, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x42, 0x79, 0x22, 0x6e, 0x0a, 0x05, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
+	0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a,
+	0x09, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16,
+	0x43, 0x4f, 0x56, 0x45, 0x52, 0x45, 0x44, 0x5f, 0x42, 0x59, 0x5f, 0x50, 0x52, 0x49, 0x4d, 0x41,
+	0x52, 0x59, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x52, 0x45, 0x46,
+	0x49, 0x58, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x10, 0x04, 0x12, 0x0a,
+	0x0a, 0x06, 0x55, 0x4e, 0x55, 0x53, 0x45, 0x44, 0x10, 0x05, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_index_health_report_proto_rawDescOnce sync.Once
+	file_index_health_report_proto_rawDescData = file_index_health_report_proto_rawDesc
+)
+
+func file_index_health_report_proto_rawDescGZIP() []byte {
+	file_index_health_report_proto_rawDescOnce.Do(func() {
+		file_index_health_report_proto_rawDescData = protoimpl.X.CompressGZIP(file_index_health_report_proto_rawDescData)
+	})
+	return file_index_health_report_proto_rawDescData
+}
+
+var file_index_health_report_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_index_health_report_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_index_health_report_proto_goTypes = []interface{}{
+	(IndexHealthIssue_Issue)(0),            // 0: pganalyze.collector.IndexHealthIssue.Issue
+	(*IndexHealthReportData)(nil),          // 1: pganalyze.collector.IndexHealthReportData
+	(*IndexHealthDatabaseInformation)(nil), // 2: pganalyze.collector.IndexHealthDatabaseInformation
+	(*IndexHealthIssue)(nil),               // 3: pganalyze.collector.IndexHealthIssue
+	(*DatabaseReference)(nil),              // 4: pganalyze.collector.DatabaseReference
+	(*RelationReference)(nil),              // 5: pganalyze.collector.RelationReference
+	(*NullTimestamp)(nil),                  // 6: pganalyze.collector.NullTimestamp
+}
+var file_index_health_report_proto_depIdxs = []int32{
+	4, // 0: pganalyze.collector.IndexHealthReportData.database_references:type_name -> pganalyze.collector.DatabaseReference
+	5, // 1: pganalyze.collector.IndexHealthReportData.relation_references:type_name -> pganalyze.collector.RelationReference
+	2, // 2: pganalyze.collector.IndexHealthReportData.database_informations:type_name -> pganalyze.collector.IndexHealthDatabaseInformation
+	3, // 3: pganalyze.collector.IndexHealthReportData.issues:type_name -> pganalyze.collector.IndexHealthIssue
+	6, // 4: pganalyze.collector.IndexHealthDatabaseInformation.stats_reset_at:type_name -> pganalyze.collector.NullTimestamp
+	0, // 5: pganalyze.collector.IndexHealthIssue.issue:type_name -> pganalyze.collector.IndexHealthIssue.Issue
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_index_health_report_proto_init() }
+func file_index_health_report_proto_init() {
+	if File_index_health_report_proto != nil {
+		return
+	}
+	file_shared_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_index_health_report_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexHealthReportData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_index_health_report_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexHealthDatabaseInformation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_index_health_report_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexHealthIssue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_index_health_report_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_index_health_report_proto_goTypes,
+		DependencyIndexes: file_index_health_report_proto_depIdxs,
+		EnumInfos:         file_index_health_report_proto_enumTypes,
+		MessageInfos:      file_index_health_report_proto_msgTypes,
+	}.Build()
+	File_index_health_report_proto = out.File
+	file_index_health_report_proto_rawDesc = nil
+	file_index_health_report_proto_goTypes = nil
+	file_index_health_report_proto_depIdxs = nil
+}
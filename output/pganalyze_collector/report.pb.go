@@ -39,6 +39,10 @@ type Report struct {
 	//	*Report_BuffercacheReportData
 	//	*Report_VacuumReportData
 	//	*Report_SequenceReportData
+	//	*Report_IndexHealthReportData
+	//	*Report_WraparoundReportData
+	//	*Report_SchemaChangesReportData
+	//	*Report_HaRoleChangesReportData
 	Data isReport_Data `protobuf_oneof:"data"`
 }
 
@@ -130,6 +134,34 @@ func (x *Report) GetSequenceReportData() *SequenceReportData {
 	return nil
 }
 
+func (x *Report) GetIndexHealthReportData() *IndexHealthReportData {
+	if x, ok := x.GetData().(*Report_IndexHealthReportData); ok {
+		return x.IndexHealthReportData
+	}
+	return nil
+}
+
+func (x *Report) GetWraparoundReportData() *WraparoundReportData {
+	if x, ok := x.GetData().(*Report_WraparoundReportData); ok {
+		return x.WraparoundReportData
+	}
+	return nil
+}
+
+func (x *Report) GetSchemaChangesReportData() *SchemaChangesReportData {
+	if x, ok := x.GetData().(*Report_SchemaChangesReportData); ok {
+		return x.SchemaChangesReportData
+	}
+	return nil
+}
+
+func (x *Report) GetHaRoleChangesReportData() *HARoleChangesReportData {
+	if x, ok := x.GetData().(*Report_HaRoleChangesReportData); ok {
+		return x.HaRoleChangesReportData
+	}
+	return nil
+}
+
 type isReport_Data interface {
 	isReport_Data()
 }
@@ -150,6 +182,22 @@ type Report_SequenceReportData struct {
 	SequenceReportData *SequenceReportData `protobuf:"bytes,13,opt,name=sequence_report_data,json=sequenceReportData,proto3,oneof"`
 }
 
+type Report_IndexHealthReportData struct {
+	IndexHealthReportData *IndexHealthReportData `protobuf:"bytes,14,opt,name=index_health_report_data,json=indexHealthReportData,proto3,oneof"`
+}
+
+type Report_WraparoundReportData struct {
+	WraparoundReportData *WraparoundReportData `protobuf:"bytes,15,opt,name=wraparound_report_data,json=wraparoundReportData,proto3,oneof"`
+}
+
+type Report_SchemaChangesReportData struct {
+	SchemaChangesReportData *SchemaChangesReportData `protobuf:"bytes,16,opt,name=schema_changes_report_data,json=schemaChangesReportData,proto3,oneof"`
+}
+
+type Report_HaRoleChangesReportData struct {
+	HaRoleChangesReportData *HARoleChangesReportData `protobuf:"bytes,17,opt,name=ha_role_changes_report_data,json=haRoleChangesReportData,proto3,oneof"`
+}
+
 func (*Report_BloatReportData) isReport_Data() {}
 
 func (*Report_BuffercacheReportData) isReport_Data() {}
@@ -158,6 +206,14 @@ func (*Report_VacuumReportData) isReport_Data() {}
 
 func (*Report_SequenceReportData) isReport_Data() {}
 
+func (*Report_IndexHealthReportData) isReport_Data() {}
+
+func (*Report_WraparoundReportData) isReport_Data() {}
+
+func (*Report_SchemaChangesReportData) isReport_Data() {}
+
+func (*Report_HaRoleChangesReportData) isReport_Data() {}
+
 var File_report_proto protoreflect.FileDescriptor
 
 var file_report_proto_rawDesc = []byte{
@@ -170,40 +226,73 @@ var file_report_proto_rawDesc = []byte{
 	0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x13, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72,
 	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
-	0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x82,
-	0x04, 0x0a, 0x06, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x72, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a,
-	0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3d,
-	0x0a, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x52, 0x0a,
-	0x11, 0x62, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61,
-	0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x42,
-	0x6c, 0x6f, 0x61, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00,
-	0x52, 0x0f, 0x62, 0x6c, 0x6f, 0x61, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x64, 0x0a, 0x17, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x63, 0x61, 0x63, 0x68, 0x65,
-	0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x63,
-	0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00,
-	0x52, 0x15, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x63, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x55, 0x0a, 0x12, 0x76, 0x61, 0x63, 0x75, 0x75,
-	0x6d, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e,
-	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d,
-	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x10, 0x76, 0x61,
-	0x63, 0x75, 0x75, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x5b,
-	0x0a, 0x14, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70,
+	0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x77, 0x72, 0x61, 0x70, 0x61,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1b, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1c, 0x68, 0x61, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa7, 0x07,
+	0x0a, 0x06, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x52, 0x0a, 0x11,
+	0x62, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c,
+	0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x42, 0x6c,
+	0x6f, 0x61, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52,
+	0x0f, 0x62, 0x6c, 0x6f, 0x61, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x64, 0x0a, 0x17, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52,
+	0x15, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x63, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x55, 0x0a, 0x12, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d,
+	0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x10, 0x76, 0x61, 0x63,
+	0x75, 0x75, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x5b, 0x0a,
+	0x14, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x67,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x12, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x65, 0x0a, 0x18, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x70,
 	0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x2e, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x12, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
-	0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x42, 0x06, 0x0a, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x72, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x15, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x61, 0x0a, 0x16, 0x77, 0x72, 0x61, 0x70, 0x61, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x57, 0x72, 0x61, 0x70, 0x61, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x14,
+	0x77, 0x72, 0x61, 0x70, 0x61, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x6b, 0x0a, 0x1a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61,
+	0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x17, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x6c, 0x0a, 0x1b, 0x68, 0x61, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79,
+	0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x48, 0x41, 0x52,
+	0x6f, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x17, 0x68, 0x61, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x42,
+	0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -220,12 +309,16 @@ func file_report_proto_rawDescGZIP() []byte {
 
 var file_report_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_report_proto_goTypes = []interface{}{
-	(*Report)(nil),                // 0: pganalyze.collector.Report
-	(*timestamp.Timestamp)(nil),   // 1: google.protobuf.Timestamp
-	(*BloatReportData)(nil),       // 2: pganalyze.collector.BloatReportData
-	(*BuffercacheReportData)(nil), // 3: pganalyze.collector.BuffercacheReportData
-	(*VacuumReportData)(nil),      // 4: pganalyze.collector.VacuumReportData
-	(*SequenceReportData)(nil),    // 5: pganalyze.collector.SequenceReportData
+	(*Report)(nil),                  // 0: pganalyze.collector.Report
+	(*timestamp.Timestamp)(nil),     // 1: google.protobuf.Timestamp
+	(*BloatReportData)(nil),         // 2: pganalyze.collector.BloatReportData
+	(*BuffercacheReportData)(nil),   // 3: pganalyze.collector.BuffercacheReportData
+	(*VacuumReportData)(nil),        // 4: pganalyze.collector.VacuumReportData
+	(*SequenceReportData)(nil),      // 5: pganalyze.collector.SequenceReportData
+	(*IndexHealthReportData)(nil),   // 6: pganalyze.collector.IndexHealthReportData
+	(*WraparoundReportData)(nil),    // 7: pganalyze.collector.WraparoundReportData
+	(*SchemaChangesReportData)(nil), // 8: pganalyze.collector.SchemaChangesReportData
+	(*HARoleChangesReportData)(nil), // 9: pganalyze.collector.HARoleChangesReportData
 }
 var file_report_proto_depIdxs = []int32{
 	1, // 0: pganalyze.collector.Report.collected_at:type_name -> google.protobuf.Timestamp
@@ -233,11 +326,15 @@ var file_report_proto_depIdxs = []int32{
 	3, // 2: pganalyze.collector.Report.buffercache_report_data:type_name -> pganalyze.collector.BuffercacheReportData
 	4, // 3: pganalyze.collector.Report.vacuum_report_data:type_name -> pganalyze.collector.VacuumReportData
 	5, // 4: pganalyze.collector.Report.sequence_report_data:type_name -> pganalyze.collector.SequenceReportData
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	6, // 5: pganalyze.collector.Report.index_health_report_data:type_name -> pganalyze.collector.IndexHealthReportData
+	7, // 6: pganalyze.collector.Report.wraparound_report_data:type_name -> pganalyze.collector.WraparoundReportData
+	8, // 7: pganalyze.collector.Report.schema_changes_report_data:type_name -> pganalyze.collector.SchemaChangesReportData
+	9, // 8: pganalyze.collector.Report.ha_role_changes_report_data:type_name -> pganalyze.collector.HARoleChangesReportData
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_report_proto_init() }
@@ -249,6 +346,10 @@ func file_report_proto_init() {
 	file_buffercache_report_proto_init()
 	file_vacuum_report_proto_init()
 	file_sequence_report_proto_init()
+	file_index_health_report_proto_init()
+	file_wraparound_report_proto_init()
+	file_schema_changes_report_proto_init()
+	file_ha_role_changes_report_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_report_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Report); i {
@@ -268,6 +369,10 @@ func file_report_proto_init() {
 		(*Report_BuffercacheReportData)(nil),
 		(*Report_VacuumReportData)(nil),
 		(*Report_SequenceReportData)(nil),
+		(*Report_IndexHealthReportData)(nil),
+		(*Report_WraparoundReportData)(nil),
+		(*Report_SchemaChangesReportData)(nil),
+		(*Report_HaRoleChangesReportData)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
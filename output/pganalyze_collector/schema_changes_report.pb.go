@@ -0,0 +1,171 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.13.0
+// source: schema_changes_report.proto
+
+package pganalyze_collector
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type SchemaChangesReportData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DatabaseReferences []*DatabaseReference `protobuf:"bytes,10,rep,name=database_references,json=databaseReferences,proto3" json:"database_references,omitempty"`
+	SchemaChanges      []*SchemaChange      `protobuf:"bytes,20,rep,name=schema_changes,json=schemaChanges,proto3" json:"schema_changes,omitempty"` // Rolling change log, most recent change first
+}
+
+func (x *SchemaChangesReportData) Reset() {
+	*x = SchemaChangesReportData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_changes_report_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchemaChangesReportData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaChangesReportData) ProtoMessage() {}
+
+func (x *SchemaChangesReportData) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_changes_report_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaChangesReportData.ProtoReflect.Descriptor instead.
+func (*SchemaChangesReportData) Descriptor() ([]byte, []int) {
+	return file_schema_changes_report_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SchemaChangesReportData) GetDatabaseReferences() []*DatabaseReference {
+	if x != nil {
+		return x.DatabaseReferences
+	}
+	return nil
+}
+
+func (x *SchemaChangesReportData) GetSchemaChanges() []*SchemaChange {
+	if x != nil {
+		return x.SchemaChanges
+	}
+	return nil
+}
+
+var File_schema_changes_report_proto protoreflect.FileDescriptor
+
+var file_schema_changes_report_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x70,
+	0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x1a, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xbc, 0x01, 0x0a, 0x17, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x57, 0x0a, 0x13,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x67, 0x61, 0x6e,
+	0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x12, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_schema_changes_report_proto_rawDescOnce sync.Once
+	file_schema_changes_report_proto_rawDescData = file_schema_changes_report_proto_rawDesc
+)
+
+func file_schema_changes_report_proto_rawDescGZIP() []byte {
+	file_schema_changes_report_proto_rawDescOnce.Do(func() {
+		file_schema_changes_report_proto_rawDescData = protoimpl.X.CompressGZIP(file_schema_changes_report_proto_rawDescData)
+	})
+	return file_schema_changes_report_proto_rawDescData
+}
+
+var file_schema_changes_report_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_schema_changes_report_proto_goTypes = []interface{}{
+	(*SchemaChangesReportData)(nil), // 0: pganalyze.collector.SchemaChangesReportData
+	(*DatabaseReference)(nil),       // 1: pganalyze.collector.DatabaseReference
+	(*SchemaChange)(nil),            // 2: pganalyze.collector.SchemaChange
+}
+var file_schema_changes_report_proto_depIdxs = []int32{
+	1, // 0: pganalyze.collector.SchemaChangesReportData.database_references:type_name -> pganalyze.collector.DatabaseReference
+	2, // 1: pganalyze.collector.SchemaChangesReportData.schema_changes:type_name -> pganalyze.collector.SchemaChange
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_schema_changes_report_proto_init() }
+func file_schema_changes_report_proto_init() {
+	if File_schema_changes_report_proto != nil {
+		return
+	}
+	file_shared_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_schema_changes_report_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchemaChangesReportData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_schema_changes_report_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_schema_changes_report_proto_goTypes,
+		DependencyIndexes: file_schema_changes_report_proto_depIdxs,
+		MessageInfos:      file_schema_changes_report_proto_msgTypes,
+	}.Build()
+	File_schema_changes_report_proto = out.File
+	file_schema_changes_report_proto_rawDesc = nil
+	file_schema_changes_report_proto_goTypes = nil
+	file_schema_changes_report_proto_depIdxs = nil
+}
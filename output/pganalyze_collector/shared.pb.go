@@ -179,6 +179,116 @@ func (SystemInformation_SystemType) EnumDescriptor() ([]byte, []int) {
 	return file_shared_proto_rawDescGZIP(), []int{12, 0}
 }
 
+type SchemaChange_ObjectType int32
+
+const (
+	SchemaChange_UNKNOWN_OBJECT SchemaChange_ObjectType = 0
+	SchemaChange_RELATION       SchemaChange_ObjectType = 1
+	SchemaChange_COLUMN         SchemaChange_ObjectType = 2
+	SchemaChange_INDEX          SchemaChange_ObjectType = 3
+	SchemaChange_CONSTRAINT     SchemaChange_ObjectType = 4
+	SchemaChange_FUNCTION       SchemaChange_ObjectType = 5
+)
+
+// Enum value maps for SchemaChange_ObjectType.
+var (
+	SchemaChange_ObjectType_name = map[int32]string{
+		0: "UNKNOWN_OBJECT",
+		1: "RELATION",
+		2: "COLUMN",
+		3: "INDEX",
+		4: "CONSTRAINT",
+		5: "FUNCTION",
+	}
+	SchemaChange_ObjectType_value = map[string]int32{
+		"UNKNOWN_OBJECT": 0,
+		"RELATION":       1,
+		"COLUMN":         2,
+		"INDEX":          3,
+		"CONSTRAINT":     4,
+		"FUNCTION":       5,
+	}
+)
+
+func (x SchemaChange_ObjectType) Enum() *SchemaChange_ObjectType {
+	p := new(SchemaChange_ObjectType)
+	*p = x
+	return p
+}
+
+func (x SchemaChange_ObjectType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SchemaChange_ObjectType) Descriptor() protoreflect.EnumDescriptor {
+	return file_shared_proto_enumTypes[3].Descriptor()
+}
+
+func (SchemaChange_ObjectType) Type() protoreflect.EnumType {
+	return &file_shared_proto_enumTypes[3]
+}
+
+func (x SchemaChange_ObjectType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SchemaChange_ObjectType.Descriptor instead.
+func (SchemaChange_ObjectType) EnumDescriptor() ([]byte, []int) {
+	return file_shared_proto_rawDescGZIP(), []int{28, 0}
+}
+
+type SchemaChange_ChangeType int32
+
+const (
+	SchemaChange_UNKNOWN_CHANGE SchemaChange_ChangeType = 0
+	SchemaChange_ADDED          SchemaChange_ChangeType = 1
+	SchemaChange_DROPPED        SchemaChange_ChangeType = 2
+	SchemaChange_ALTERED        SchemaChange_ChangeType = 3
+)
+
+// Enum value maps for SchemaChange_ChangeType.
+var (
+	SchemaChange_ChangeType_name = map[int32]string{
+		0: "UNKNOWN_CHANGE",
+		1: "ADDED",
+		2: "DROPPED",
+		3: "ALTERED",
+	}
+	SchemaChange_ChangeType_value = map[string]int32{
+		"UNKNOWN_CHANGE": 0,
+		"ADDED":          1,
+		"DROPPED":        2,
+		"ALTERED":        3,
+	}
+)
+
+func (x SchemaChange_ChangeType) Enum() *SchemaChange_ChangeType {
+	p := new(SchemaChange_ChangeType)
+	*p = x
+	return p
+}
+
+func (x SchemaChange_ChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SchemaChange_ChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_shared_proto_enumTypes[4].Descriptor()
+}
+
+func (SchemaChange_ChangeType) Type() protoreflect.EnumType {
+	return &file_shared_proto_enumTypes[4]
+}
+
+func (x SchemaChange_ChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SchemaChange_ChangeType.Descriptor instead.
+func (SchemaChange_ChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_shared_proto_rawDescGZIP(), []int{28, 1}
+}
+
 type NullString struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2568,6 +2678,261 @@ func (x *DiskPartitionStatistic) GetTotalBytes() uint64 {
 	return 0
 }
 
+// Schema change detected between two consecutive full snapshots
+type SchemaChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DetectedAt   *timestamp.Timestamp    `protobuf:"bytes,1,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+	DatabaseIdx  int32                   `protobuf:"varint,2,opt,name=database_idx,json=databaseIdx,proto3" json:"database_idx,omitempty"`
+	ObjectType   SchemaChange_ObjectType `protobuf:"varint,3,opt,name=object_type,json=objectType,proto3,enum=pganalyze.collector.SchemaChange_ObjectType" json:"object_type,omitempty"`
+	ChangeType   SchemaChange_ChangeType `protobuf:"varint,4,opt,name=change_type,json=changeType,proto3,enum=pganalyze.collector.SchemaChange_ChangeType" json:"change_type,omitempty"`
+	SchemaName   string                  `protobuf:"bytes,5,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	RelationName string                  `protobuf:"bytes,6,opt,name=relation_name,json=relationName,proto3" json:"relation_name,omitempty"` // Empty for functions
+	ObjectName   string                  `protobuf:"bytes,7,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`       // Column, index or constraint name, or function signature (empty for relations)
+	Details      []string                `protobuf:"bytes,8,rep,name=details,proto3" json:"details,omitempty"`                               // What was altered, e.g. "type changed from integer to bigint"
+}
+
+func (x *SchemaChange) Reset() {
+	*x = SchemaChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchemaChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaChange) ProtoMessage() {}
+
+func (x *SchemaChange) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaChange.ProtoReflect.Descriptor instead.
+func (*SchemaChange) Descriptor() ([]byte, []int) {
+	return file_shared_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SchemaChange) GetDetectedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return nil
+}
+
+func (x *SchemaChange) GetDatabaseIdx() int32 {
+	if x != nil {
+		return x.DatabaseIdx
+	}
+	return 0
+}
+
+func (x *SchemaChange) GetObjectType() SchemaChange_ObjectType {
+	if x != nil {
+		return x.ObjectType
+	}
+	return SchemaChange_UNKNOWN_OBJECT
+}
+
+func (x *SchemaChange) GetChangeType() SchemaChange_ChangeType {
+	if x != nil {
+		return x.ChangeType
+	}
+	return SchemaChange_UNKNOWN_CHANGE
+}
+
+func (x *SchemaChange) GetSchemaName() string {
+	if x != nil {
+		return x.SchemaName
+	}
+	return ""
+}
+
+func (x *SchemaChange) GetRelationName() string {
+	if x != nil {
+		return x.RelationName
+	}
+	return ""
+}
+
+func (x *SchemaChange) GetObjectName() string {
+	if x != nil {
+		return x.ObjectName
+	}
+	return ""
+}
+
+func (x *SchemaChange) GetDetails() []string {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+// Switch of the collector to a different member of a high availability cluster, e.g. after a failover
+type HARoleChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DetectedAt     *timestamp.Timestamp   `protobuf:"bytes,1,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+	Role           string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`                                           // Role the collector follows (primary or replica)
+	PreviousMember string                 `protobuf:"bytes,3,opt,name=previous_member,json=previousMember,proto3" json:"previous_member,omitempty"` // Host and port, e.g. "10.0.0.1:5432"
+	NewMember      string                 `protobuf:"bytes,4,opt,name=new_member,json=newMember,proto3" json:"new_member,omitempty"`
+	Members        []*HARoleChange_Member `protobuf:"bytes,5,rep,name=members,proto3" json:"members,omitempty"` // All members with their roles at the time of the change
+}
+
+func (x *HARoleChange) Reset() {
+	*x = HARoleChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HARoleChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HARoleChange) ProtoMessage() {}
+
+func (x *HARoleChange) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HARoleChange.ProtoReflect.Descriptor instead.
+func (*HARoleChange) Descriptor() ([]byte, []int) {
+	return file_shared_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *HARoleChange) GetDetectedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return nil
+}
+
+func (x *HARoleChange) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *HARoleChange) GetPreviousMember() string {
+	if x != nil {
+		return x.PreviousMember
+	}
+	return ""
+}
+
+func (x *HARoleChange) GetNewMember() string {
+	if x != nil {
+		return x.NewMember
+	}
+	return ""
+}
+
+func (x *HARoleChange) GetMembers() []*HARoleChange_Member {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type HARoleChange_Member struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Patroni member name (empty for members listed in db_ha_members)
+	Host string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Port int32  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	Role string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"` // primary, replica or unreachable
+}
+
+func (x *HARoleChange_Member) Reset() {
+	*x = HARoleChange_Member{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HARoleChange_Member) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HARoleChange_Member) ProtoMessage() {}
+
+func (x *HARoleChange_Member) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HARoleChange_Member.ProtoReflect.Descriptor instead.
+func (*HARoleChange_Member) Descriptor() ([]byte, []int) {
+	return file_shared_proto_rawDescGZIP(), []int{29, 0}
+}
+
+func (x *HARoleChange_Member) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HARoleChange_Member) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *HARoleChange_Member) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *HARoleChange_Member) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
 var File_shared_proto protoreflect.FileDescriptor
 
 var file_shared_proto_rawDesc = []byte{
@@ -3092,8 +3457,64 @@ var file_shared_proto_rawDesc = []byte{
 	0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
 	0x75, 0x73, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74,
 	0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xb9, 0x04, 0x0a, 0x0c, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x64,
+	0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x64, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x64, 0x78, 0x12, 0x4d, 0x0a, 0x0b, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x2c, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4d, 0x0a, 0x0b, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x2c, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x63, 0x0a, 0x0a, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
+	0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4f,
+	0x4c, 0x55, 0x4d, 0x4e, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10,
+	0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x4e, 0x53, 0x54, 0x52, 0x41, 0x49, 0x4e, 0x54, 0x10,
+	0x04, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x22,
+	0x45, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
+	0x0e, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10,
+	0x00, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07,
+	0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x4c, 0x54,
+	0x45, 0x52, 0x45, 0x44, 0x10, 0x03, 0x22, 0xc5, 0x02, 0x0a, 0x0c, 0x48, 0x41, 0x52, 0x6f, 0x6c,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x64, 0x65, 0x74, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x42, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x48, 0x41, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x1a, 0x58, 0x0a, 0x06, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3108,71 +3529,81 @@ func file_shared_proto_rawDescGZIP() []byte {
 	return file_shared_proto_rawDescData
 }
 
-var file_shared_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_shared_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_shared_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_shared_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_shared_proto_goTypes = []interface{}{
 	(QueryExplainInformation_ExplainFormat)(0), // 0: pganalyze.collector.QueryExplainInformation.ExplainFormat
 	(QueryExplainInformation_ExplainSource)(0), // 1: pganalyze.collector.QueryExplainInformation.ExplainSource
 	(SystemInformation_SystemType)(0),          // 2: pganalyze.collector.SystemInformation.SystemType
-	(*NullString)(nil),                         // 3: pganalyze.collector.NullString
-	(*NullTimestamp)(nil),                      // 4: pganalyze.collector.NullTimestamp
-	(*PostgresVersion)(nil),                    // 5: pganalyze.collector.PostgresVersion
-	(*RoleReference)(nil),                      // 6: pganalyze.collector.RoleReference
-	(*DatabaseReference)(nil),                  // 7: pganalyze.collector.DatabaseReference
-	(*RelationReference)(nil),                  // 8: pganalyze.collector.RelationReference
-	(*IndexReference)(nil),                     // 9: pganalyze.collector.IndexReference
-	(*FunctionReference)(nil),                  // 10: pganalyze.collector.FunctionReference
-	(*QueryReference)(nil),                     // 11: pganalyze.collector.QueryReference
-	(*QueryInformation)(nil),                   // 12: pganalyze.collector.QueryInformation
-	(*QueryExplainInformation)(nil),            // 13: pganalyze.collector.QueryExplainInformation
-	(*System)(nil),                             // 14: pganalyze.collector.System
-	(*SystemInformation)(nil),                  // 15: pganalyze.collector.SystemInformation
-	(*SystemInformationSelfHosted)(nil),        // 16: pganalyze.collector.SystemInformationSelfHosted
-	(*SystemInformationAmazonRDS)(nil),         // 17: pganalyze.collector.SystemInformationAmazonRDS
-	(*SchedulerStatistic)(nil),                 // 18: pganalyze.collector.SchedulerStatistic
-	(*MemoryStatistic)(nil),                    // 19: pganalyze.collector.MemoryStatistic
-	(*CPUInformation)(nil),                     // 20: pganalyze.collector.CPUInformation
-	(*CPUReference)(nil),                       // 21: pganalyze.collector.CPUReference
-	(*CPUStatistic)(nil),                       // 22: pganalyze.collector.CPUStatistic
-	(*NetworkReference)(nil),                   // 23: pganalyze.collector.NetworkReference
-	(*NetworkStatistic)(nil),                   // 24: pganalyze.collector.NetworkStatistic
-	(*DiskReference)(nil),                      // 25: pganalyze.collector.DiskReference
-	(*DiskInformation)(nil),                    // 26: pganalyze.collector.DiskInformation
-	(*DiskStatistic)(nil),                      // 27: pganalyze.collector.DiskStatistic
-	(*DiskPartitionReference)(nil),             // 28: pganalyze.collector.DiskPartitionReference
-	(*DiskPartitionInformation)(nil),           // 29: pganalyze.collector.DiskPartitionInformation
-	(*DiskPartitionStatistic)(nil),             // 30: pganalyze.collector.DiskPartitionStatistic
-	(*timestamp.Timestamp)(nil),                // 31: google.protobuf.Timestamp
+	(SchemaChange_ObjectType)(0),               // 3: pganalyze.collector.SchemaChange.ObjectType
+	(SchemaChange_ChangeType)(0),               // 4: pganalyze.collector.SchemaChange.ChangeType
+	(*NullString)(nil),                         // 5: pganalyze.collector.NullString
+	(*NullTimestamp)(nil),                      // 6: pganalyze.collector.NullTimestamp
+	(*PostgresVersion)(nil),                    // 7: pganalyze.collector.PostgresVersion
+	(*RoleReference)(nil),                      // 8: pganalyze.collector.RoleReference
+	(*DatabaseReference)(nil),                  // 9: pganalyze.collector.DatabaseReference
+	(*RelationReference)(nil),                  // 10: pganalyze.collector.RelationReference
+	(*IndexReference)(nil),                     // 11: pganalyze.collector.IndexReference
+	(*FunctionReference)(nil),                  // 12: pganalyze.collector.FunctionReference
+	(*QueryReference)(nil),                     // 13: pganalyze.collector.QueryReference
+	(*QueryInformation)(nil),                   // 14: pganalyze.collector.QueryInformation
+	(*QueryExplainInformation)(nil),            // 15: pganalyze.collector.QueryExplainInformation
+	(*System)(nil),                             // 16: pganalyze.collector.System
+	(*SystemInformation)(nil),                  // 17: pganalyze.collector.SystemInformation
+	(*SystemInformationSelfHosted)(nil),        // 18: pganalyze.collector.SystemInformationSelfHosted
+	(*SystemInformationAmazonRDS)(nil),         // 19: pganalyze.collector.SystemInformationAmazonRDS
+	(*SchedulerStatistic)(nil),                 // 20: pganalyze.collector.SchedulerStatistic
+	(*MemoryStatistic)(nil),                    // 21: pganalyze.collector.MemoryStatistic
+	(*CPUInformation)(nil),                     // 22: pganalyze.collector.CPUInformation
+	(*CPUReference)(nil),                       // 23: pganalyze.collector.CPUReference
+	(*CPUStatistic)(nil),                       // 24: pganalyze.collector.CPUStatistic
+	(*NetworkReference)(nil),                   // 25: pganalyze.collector.NetworkReference
+	(*NetworkStatistic)(nil),                   // 26: pganalyze.collector.NetworkStatistic
+	(*DiskReference)(nil),                      // 27: pganalyze.collector.DiskReference
+	(*DiskInformation)(nil),                    // 28: pganalyze.collector.DiskInformation
+	(*DiskStatistic)(nil),                      // 29: pganalyze.collector.DiskStatistic
+	(*DiskPartitionReference)(nil),             // 30: pganalyze.collector.DiskPartitionReference
+	(*DiskPartitionInformation)(nil),           // 31: pganalyze.collector.DiskPartitionInformation
+	(*DiskPartitionStatistic)(nil),             // 32: pganalyze.collector.DiskPartitionStatistic
+	(*SchemaChange)(nil),                       // 33: pganalyze.collector.SchemaChange
+	(*HARoleChange)(nil),                       // 34: pganalyze.collector.HARoleChange
+	(*HARoleChange_Member)(nil),                // 35: pganalyze.collector.HARoleChange.Member
+	(*timestamp.Timestamp)(nil),                // 36: google.protobuf.Timestamp
 }
 var file_shared_proto_depIdxs = []int32{
-	31, // 0: pganalyze.collector.NullTimestamp.value:type_name -> google.protobuf.Timestamp
+	36, // 0: pganalyze.collector.NullTimestamp.value:type_name -> google.protobuf.Timestamp
 	0,  // 1: pganalyze.collector.QueryExplainInformation.explain_format:type_name -> pganalyze.collector.QueryExplainInformation.ExplainFormat
 	1,  // 2: pganalyze.collector.QueryExplainInformation.explain_source:type_name -> pganalyze.collector.QueryExplainInformation.ExplainSource
-	15, // 3: pganalyze.collector.System.system_information:type_name -> pganalyze.collector.SystemInformation
-	18, // 4: pganalyze.collector.System.scheduler_statistic:type_name -> pganalyze.collector.SchedulerStatistic
-	19, // 5: pganalyze.collector.System.memory_statistic:type_name -> pganalyze.collector.MemoryStatistic
-	20, // 6: pganalyze.collector.System.cpu_information:type_name -> pganalyze.collector.CPUInformation
-	21, // 7: pganalyze.collector.System.cpu_references:type_name -> pganalyze.collector.CPUReference
-	22, // 8: pganalyze.collector.System.cpu_statistics:type_name -> pganalyze.collector.CPUStatistic
-	23, // 9: pganalyze.collector.System.network_references:type_name -> pganalyze.collector.NetworkReference
-	24, // 10: pganalyze.collector.System.network_statistics:type_name -> pganalyze.collector.NetworkStatistic
-	25, // 11: pganalyze.collector.System.disk_references:type_name -> pganalyze.collector.DiskReference
-	26, // 12: pganalyze.collector.System.disk_informations:type_name -> pganalyze.collector.DiskInformation
-	27, // 13: pganalyze.collector.System.disk_statistics:type_name -> pganalyze.collector.DiskStatistic
-	28, // 14: pganalyze.collector.System.disk_partition_references:type_name -> pganalyze.collector.DiskPartitionReference
-	29, // 15: pganalyze.collector.System.disk_partition_informations:type_name -> pganalyze.collector.DiskPartitionInformation
-	30, // 16: pganalyze.collector.System.disk_partition_statistics:type_name -> pganalyze.collector.DiskPartitionStatistic
+	17, // 3: pganalyze.collector.System.system_information:type_name -> pganalyze.collector.SystemInformation
+	20, // 4: pganalyze.collector.System.scheduler_statistic:type_name -> pganalyze.collector.SchedulerStatistic
+	21, // 5: pganalyze.collector.System.memory_statistic:type_name -> pganalyze.collector.MemoryStatistic
+	22, // 6: pganalyze.collector.System.cpu_information:type_name -> pganalyze.collector.CPUInformation
+	23, // 7: pganalyze.collector.System.cpu_references:type_name -> pganalyze.collector.CPUReference
+	24, // 8: pganalyze.collector.System.cpu_statistics:type_name -> pganalyze.collector.CPUStatistic
+	25, // 9: pganalyze.collector.System.network_references:type_name -> pganalyze.collector.NetworkReference
+	26, // 10: pganalyze.collector.System.network_statistics:type_name -> pganalyze.collector.NetworkStatistic
+	27, // 11: pganalyze.collector.System.disk_references:type_name -> pganalyze.collector.DiskReference
+	28, // 12: pganalyze.collector.System.disk_informations:type_name -> pganalyze.collector.DiskInformation
+	29, // 13: pganalyze.collector.System.disk_statistics:type_name -> pganalyze.collector.DiskStatistic
+	30, // 14: pganalyze.collector.System.disk_partition_references:type_name -> pganalyze.collector.DiskPartitionReference
+	31, // 15: pganalyze.collector.System.disk_partition_informations:type_name -> pganalyze.collector.DiskPartitionInformation
+	32, // 16: pganalyze.collector.System.disk_partition_statistics:type_name -> pganalyze.collector.DiskPartitionStatistic
 	2,  // 17: pganalyze.collector.SystemInformation.type:type_name -> pganalyze.collector.SystemInformation.SystemType
-	16, // 18: pganalyze.collector.SystemInformation.self_hosted:type_name -> pganalyze.collector.SystemInformationSelfHosted
-	17, // 19: pganalyze.collector.SystemInformation.amazon_rds:type_name -> pganalyze.collector.SystemInformationAmazonRDS
-	31, // 20: pganalyze.collector.SystemInformation.boot_time:type_name -> google.protobuf.Timestamp
-	31, // 21: pganalyze.collector.SystemInformationAmazonRDS.latest_restorable_time:type_name -> google.protobuf.Timestamp
-	31, // 22: pganalyze.collector.SystemInformationAmazonRDS.created_at:type_name -> google.protobuf.Timestamp
-	23, // [23:23] is the sub-list for method output_type
-	23, // [23:23] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	18, // 18: pganalyze.collector.SystemInformation.self_hosted:type_name -> pganalyze.collector.SystemInformationSelfHosted
+	19, // 19: pganalyze.collector.SystemInformation.amazon_rds:type_name -> pganalyze.collector.SystemInformationAmazonRDS
+	36, // 20: pganalyze.collector.SystemInformation.boot_time:type_name -> google.protobuf.Timestamp
+	36, // 21: pganalyze.collector.SystemInformationAmazonRDS.latest_restorable_time:type_name -> google.protobuf.Timestamp
+	36, // 22: pganalyze.collector.SystemInformationAmazonRDS.created_at:type_name -> google.protobuf.Timestamp
+	36, // 23: pganalyze.collector.SchemaChange.detected_at:type_name -> google.protobuf.Timestamp
+	3,  // 24: pganalyze.collector.SchemaChange.object_type:type_name -> pganalyze.collector.SchemaChange.ObjectType
+	4,  // 25: pganalyze.collector.SchemaChange.change_type:type_name -> pganalyze.collector.SchemaChange.ChangeType
+	36, // 26: pganalyze.collector.HARoleChange.detected_at:type_name -> google.protobuf.Timestamp
+	35, // 27: pganalyze.collector.HARoleChange.members:type_name -> pganalyze.collector.HARoleChange.Member
+	28, // [28:28] is the sub-list for method output_type
+	28, // [28:28] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_shared_proto_init() }
@@ -3517,6 +3948,42 @@ func file_shared_proto_init() {
 				return nil
 			}
 		}
+		file_shared_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchemaChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HARoleChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HARoleChange_Member); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_shared_proto_msgTypes[12].OneofWrappers = []interface{}{
 		(*SystemInformation_SelfHosted)(nil),
@@ -3527,8 +3994,8 @@ func file_shared_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_shared_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   28,
+			NumEnums:      5,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,785 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.13.0
+// source: wraparound_report.proto
+
+package pganalyze_collector
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type XminHorizonHolder_Type int32
+
+const (
+	XminHorizonHolder_UNKNOWN              XminHorizonHolder_Type = 0
+	XminHorizonHolder_TRANSACTION          XminHorizonHolder_Type = 1
+	XminHorizonHolder_PREPARED_TRANSACTION XminHorizonHolder_Type = 2
+	XminHorizonHolder_REPLICATION_SLOT     XminHorizonHolder_Type = 3
+	XminHorizonHolder_STANDBY_FEEDBACK     XminHorizonHolder_Type = 4
+)
+
+// Enum value maps for XminHorizonHolder_Type.
+var (
+	XminHorizonHolder_Type_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "TRANSACTION",
+		2: "PREPARED_TRANSACTION",
+		3: "REPLICATION_SLOT",
+		4: "STANDBY_FEEDBACK",
+	}
+	XminHorizonHolder_Type_value = map[string]int32{
+		"UNKNOWN":              0,
+		"TRANSACTION":          1,
+		"PREPARED_TRANSACTION": 2,
+		"REPLICATION_SLOT":     3,
+		"STANDBY_FEEDBACK":     4,
+	}
+)
+
+func (x XminHorizonHolder_Type) Enum() *XminHorizonHolder_Type {
+	p := new(XminHorizonHolder_Type)
+	*p = x
+	return p
+}
+
+func (x XminHorizonHolder_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (XminHorizonHolder_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_wraparound_report_proto_enumTypes[0].Descriptor()
+}
+
+func (XminHorizonHolder_Type) Type() protoreflect.EnumType {
+	return &file_wraparound_report_proto_enumTypes[0]
+}
+
+func (x XminHorizonHolder_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use XminHorizonHolder_Type.Descriptor instead.
+func (XminHorizonHolder_Type) EnumDescriptor() ([]byte, []int) {
+	return file_wraparound_report_proto_rawDescGZIP(), []int{3, 0}
+}
+
+type WraparoundReportData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DatabaseReferences              []*DatabaseReference `protobuf:"bytes,10,rep,name=database_references,json=databaseReferences,proto3" json:"database_references,omitempty"`
+	RelationReferences              []*RelationReference `protobuf:"bytes,11,rep,name=relation_references,json=relationReferences,proto3" json:"relation_references,omitempty"`
+	AutovacuumFreezeMaxAge          int64                `protobuf:"varint,20,opt,name=autovacuum_freeze_max_age,json=autovacuumFreezeMaxAge,proto3" json:"autovacuum_freeze_max_age,omitempty"`
+	AutovacuumMultixactFreezeMaxAge int64                `protobuf:"varint,21,opt,name=autovacuum_multixact_freeze_max_age,json=autovacuumMultixactFreezeMaxAge,proto3" json:"autovacuum_multixact_freeze_max_age,omitempty"`
+	// Ordered by risk, most at risk first
+	DatabaseInformations []*WraparoundDatabaseInformation `protobuf:"bytes,22,rep,name=database_informations,json=databaseInformations,proto3" json:"database_informations,omitempty"`
+	RelationInformations []*WraparoundRelationInformation `protobuf:"bytes,23,rep,name=relation_informations,json=relationInformations,proto3" json:"relation_informations,omitempty"`
+	XminHorizonHolders   []*XminHorizonHolder             `protobuf:"bytes,24,rep,name=xmin_horizon_holders,json=xminHorizonHolders,proto3" json:"xmin_horizon_holders,omitempty"`
+	// Consumption rate since the last full snapshot, and the time remaining until the
+	// oldest database reaches autovacuum_freeze_max_age or the wraparound limit. Not
+	// set if there is no earlier sample, or the age is not increasing.
+	HasXidsPerSecond                       bool    `protobuf:"varint,30,opt,name=has_xids_per_second,json=hasXidsPerSecond,proto3" json:"has_xids_per_second,omitempty"`
+	XidsPerSecond                          float64 `protobuf:"fixed64,31,opt,name=xids_per_second,json=xidsPerSecond,proto3" json:"xids_per_second,omitempty"`
+	HasSecondsUntilEmergencyAutovacuum     bool    `protobuf:"varint,32,opt,name=has_seconds_until_emergency_autovacuum,json=hasSecondsUntilEmergencyAutovacuum,proto3" json:"has_seconds_until_emergency_autovacuum,omitempty"`
+	SecondsUntilEmergencyAutovacuum        float64 `protobuf:"fixed64,33,opt,name=seconds_until_emergency_autovacuum,json=secondsUntilEmergencyAutovacuum,proto3" json:"seconds_until_emergency_autovacuum,omitempty"`
+	HasSecondsUntilWraparound              bool    `protobuf:"varint,34,opt,name=has_seconds_until_wraparound,json=hasSecondsUntilWraparound,proto3" json:"has_seconds_until_wraparound,omitempty"`
+	SecondsUntilWraparound                 float64 `protobuf:"fixed64,35,opt,name=seconds_until_wraparound,json=secondsUntilWraparound,proto3" json:"seconds_until_wraparound,omitempty"`
+	HasMxidsPerSecond                      bool    `protobuf:"varint,36,opt,name=has_mxids_per_second,json=hasMxidsPerSecond,proto3" json:"has_mxids_per_second,omitempty"`
+	MxidsPerSecond                         float64 `protobuf:"fixed64,37,opt,name=mxids_per_second,json=mxidsPerSecond,proto3" json:"mxids_per_second,omitempty"`
+	HasMxidSecondsUntilEmergencyAutovacuum bool    `protobuf:"varint,38,opt,name=has_mxid_seconds_until_emergency_autovacuum,json=hasMxidSecondsUntilEmergencyAutovacuum,proto3" json:"has_mxid_seconds_until_emergency_autovacuum,omitempty"`
+	MxidSecondsUntilEmergencyAutovacuum    float64 `protobuf:"fixed64,39,opt,name=mxid_seconds_until_emergency_autovacuum,json=mxidSecondsUntilEmergencyAutovacuum,proto3" json:"mxid_seconds_until_emergency_autovacuum,omitempty"`
+	HasMxidSecondsUntilWraparound          bool    `protobuf:"varint,40,opt,name=has_mxid_seconds_until_wraparound,json=hasMxidSecondsUntilWraparound,proto3" json:"has_mxid_seconds_until_wraparound,omitempty"`
+	MxidSecondsUntilWraparound             float64 `protobuf:"fixed64,41,opt,name=mxid_seconds_until_wraparound,json=mxidSecondsUntilWraparound,proto3" json:"mxid_seconds_until_wraparound,omitempty"`
+}
+
+func (x *WraparoundReportData) Reset() {
+	*x = WraparoundReportData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wraparound_report_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WraparoundReportData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WraparoundReportData) ProtoMessage() {}
+
+func (x *WraparoundReportData) ProtoReflect() protoreflect.Message {
+	mi := &file_wraparound_report_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WraparoundReportData.ProtoReflect.Descriptor instead.
+func (*WraparoundReportData) Descriptor() ([]byte, []int) {
+	return file_wraparound_report_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WraparoundReportData) GetDatabaseReferences() []*DatabaseReference {
+	if x != nil {
+		return x.DatabaseReferences
+	}
+	return nil
+}
+
+func (x *WraparoundReportData) GetRelationReferences() []*RelationReference {
+	if x != nil {
+		return x.RelationReferences
+	}
+	return nil
+}
+
+func (x *WraparoundReportData) GetAutovacuumFreezeMaxAge() int64 {
+	if x != nil {
+		return x.AutovacuumFreezeMaxAge
+	}
+	return 0
+}
+
+func (x *WraparoundReportData) GetAutovacuumMultixactFreezeMaxAge() int64 {
+	if x != nil {
+		return x.AutovacuumMultixactFreezeMaxAge
+	}
+	return 0
+}
+
+func (x *WraparoundReportData) GetDatabaseInformations() []*WraparoundDatabaseInformation {
+	if x != nil {
+		return x.DatabaseInformations
+	}
+	return nil
+}
+
+func (x *WraparoundReportData) GetRelationInformations() []*WraparoundRelationInformation {
+	if x != nil {
+		return x.RelationInformations
+	}
+	return nil
+}
+
+func (x *WraparoundReportData) GetXminHorizonHolders() []*XminHorizonHolder {
+	if x != nil {
+		return x.XminHorizonHolders
+	}
+	return nil
+}
+
+func (x *WraparoundReportData) GetHasXidsPerSecond() bool {
+	if x != nil {
+		return x.HasXidsPerSecond
+	}
+	return false
+}
+
+func (x *WraparoundReportData) GetXidsPerSecond() float64 {
+	if x != nil {
+		return x.XidsPerSecond
+	}
+	return 0
+}
+
+func (x *WraparoundReportData) GetHasSecondsUntilEmergencyAutovacuum() bool {
+	if x != nil {
+		return x.HasSecondsUntilEmergencyAutovacuum
+	}
+	return false
+}
+
+func (x *WraparoundReportData) GetSecondsUntilEmergencyAutovacuum() float64 {
+	if x != nil {
+		return x.SecondsUntilEmergencyAutovacuum
+	}
+	return 0
+}
+
+func (x *WraparoundReportData) GetHasSecondsUntilWraparound() bool {
+	if x != nil {
+		return x.HasSecondsUntilWraparound
+	}
+	return false
+}
+
+func (x *WraparoundReportData) GetSecondsUntilWraparound() float64 {
+	if x != nil {
+		return x.SecondsUntilWraparound
+	}
+	return 0
+}
+
+func (x *WraparoundReportData) GetHasMxidsPerSecond() bool {
+	if x != nil {
+		return x.HasMxidsPerSecond
+	}
+	return false
+}
+
+func (x *WraparoundReportData) GetMxidsPerSecond() float64 {
+	if x != nil {
+		return x.MxidsPerSecond
+	}
+	return 0
+}
+
+func (x *WraparoundReportData) GetHasMxidSecondsUntilEmergencyAutovacuum() bool {
+	if x != nil {
+		return x.HasMxidSecondsUntilEmergencyAutovacuum
+	}
+	return false
+}
+
+func (x *WraparoundReportData) GetMxidSecondsUntilEmergencyAutovacuum() float64 {
+	if x != nil {
+		return x.MxidSecondsUntilEmergencyAutovacuum
+	}
+	return 0
+}
+
+func (x *WraparoundReportData) GetHasMxidSecondsUntilWraparound() bool {
+	if x != nil {
+		return x.HasMxidSecondsUntilWraparound
+	}
+	return false
+}
+
+func (x *WraparoundReportData) GetMxidSecondsUntilWraparound() float64 {
+	if x != nil {
+		return x.MxidSecondsUntilWraparound
+	}
+	return 0
+}
+
+type WraparoundDatabaseInformation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DatabaseIdx int32 `protobuf:"varint,1,opt,name=database_idx,json=databaseIdx,proto3" json:"database_idx,omitempty"`
+	XidAge      int64 `protobuf:"varint,2,opt,name=xid_age,json=xidAge,proto3" json:"xid_age,omitempty"` // age(datfrozenxid)
+	HasMxidAge  bool  `protobuf:"varint,3,opt,name=has_mxid_age,json=hasMxidAge,proto3" json:"has_mxid_age,omitempty"`
+	MxidAge     int64 `protobuf:"varint,4,opt,name=mxid_age,json=mxidAge,proto3" json:"mxid_age,omitempty"` // mxid_age(datminmxid)
+}
+
+func (x *WraparoundDatabaseInformation) Reset() {
+	*x = WraparoundDatabaseInformation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wraparound_report_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WraparoundDatabaseInformation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WraparoundDatabaseInformation) ProtoMessage() {}
+
+func (x *WraparoundDatabaseInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_wraparound_report_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WraparoundDatabaseInformation.ProtoReflect.Descriptor instead.
+func (*WraparoundDatabaseInformation) Descriptor() ([]byte, []int) {
+	return file_wraparound_report_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WraparoundDatabaseInformation) GetDatabaseIdx() int32 {
+	if x != nil {
+		return x.DatabaseIdx
+	}
+	return 0
+}
+
+func (x *WraparoundDatabaseInformation) GetXidAge() int64 {
+	if x != nil {
+		return x.XidAge
+	}
+	return 0
+}
+
+func (x *WraparoundDatabaseInformation) GetHasMxidAge() bool {
+	if x != nil {
+		return x.HasMxidAge
+	}
+	return false
+}
+
+func (x *WraparoundDatabaseInformation) GetMxidAge() int64 {
+	if x != nil {
+		return x.MxidAge
+	}
+	return 0
+}
+
+type WraparoundRelationInformation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RelationIdx            int32 `protobuf:"varint,1,opt,name=relation_idx,json=relationIdx,proto3" json:"relation_idx,omitempty"`
+	IsToast                bool  `protobuf:"varint,2,opt,name=is_toast,json=isToast,proto3" json:"is_toast,omitempty"` // The ages apply to the TOAST table of this relation
+	XidAge                 int64 `protobuf:"varint,3,opt,name=xid_age,json=xidAge,proto3" json:"xid_age,omitempty"`    // age(relfrozenxid)
+	HasMxidAge             bool  `protobuf:"varint,4,opt,name=has_mxid_age,json=hasMxidAge,proto3" json:"has_mxid_age,omitempty"`
+	MxidAge                int64 `protobuf:"varint,5,opt,name=mxid_age,json=mxidAge,proto3" json:"mxid_age,omitempty"`                                                  // mxid_age(relminmxid)
+	AutovacuumFreezeMaxAge int64 `protobuf:"varint,6,opt,name=autovacuum_freeze_max_age,json=autovacuumFreezeMaxAge,proto3" json:"autovacuum_freeze_max_age,omitempty"` // Effective setting, taking the table's storage parameters into account
+}
+
+func (x *WraparoundRelationInformation) Reset() {
+	*x = WraparoundRelationInformation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wraparound_report_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WraparoundRelationInformation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WraparoundRelationInformation) ProtoMessage() {}
+
+func (x *WraparoundRelationInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_wraparound_report_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WraparoundRelationInformation.ProtoReflect.Descriptor instead.
+func (*WraparoundRelationInformation) Descriptor() ([]byte, []int) {
+	return file_wraparound_report_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WraparoundRelationInformation) GetRelationIdx() int32 {
+	if x != nil {
+		return x.RelationIdx
+	}
+	return 0
+}
+
+func (x *WraparoundRelationInformation) GetIsToast() bool {
+	if x != nil {
+		return x.IsToast
+	}
+	return false
+}
+
+func (x *WraparoundRelationInformation) GetXidAge() int64 {
+	if x != nil {
+		return x.XidAge
+	}
+	return 0
+}
+
+func (x *WraparoundRelationInformation) GetHasMxidAge() bool {
+	if x != nil {
+		return x.HasMxidAge
+	}
+	return false
+}
+
+func (x *WraparoundRelationInformation) GetMxidAge() int64 {
+	if x != nil {
+		return x.MxidAge
+	}
+	return 0
+}
+
+func (x *WraparoundRelationInformation) GetAutovacuumFreezeMaxAge() int64 {
+	if x != nil {
+		return x.AutovacuumFreezeMaxAge
+	}
+	return 0
+}
+
+// What is preventing VACUUM from removing old row versions and freezing
+type XminHorizonHolder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type        XminHorizonHolder_Type `protobuf:"varint,1,opt,name=type,proto3,enum=pganalyze.collector.XminHorizonHolder_Type" json:"type,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                   // Backend PID, prepared transaction GID, replication slot name or standby application name
+	DatabaseIdx int32                  `protobuf:"varint,3,opt,name=database_idx,json=databaseIdx,proto3" json:"database_idx,omitempty"` // -1 if not connected to a database
+	RoleName    string                 `protobuf:"bytes,4,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	StartedAt   *NullTimestamp         `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	XminAge     int64                  `protobuf:"varint,6,opt,name=xmin_age,json=xminAge,proto3" json:"xmin_age,omitempty"` // Age of the oldest transaction ID the holder needs to be kept around
+}
+
+func (x *XminHorizonHolder) Reset() {
+	*x = XminHorizonHolder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wraparound_report_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XminHorizonHolder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XminHorizonHolder) ProtoMessage() {}
+
+func (x *XminHorizonHolder) ProtoReflect() protoreflect.Message {
+	mi := &file_wraparound_report_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XminHorizonHolder.ProtoReflect.Descriptor instead.
+func (*XminHorizonHolder) Descriptor() ([]byte, []int) {
+	return file_wraparound_report_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *XminHorizonHolder) GetType() XminHorizonHolder_Type {
+	if x != nil {
+		return x.Type
+	}
+	return XminHorizonHolder_UNKNOWN
+}
+
+func (x *XminHorizonHolder) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *XminHorizonHolder) GetDatabaseIdx() int32 {
+	if x != nil {
+		return x.DatabaseIdx
+	}
+	return 0
+}
+
+func (x *XminHorizonHolder) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
+func (x *XminHorizonHolder) GetStartedAt() *NullTimestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *XminHorizonHolder) GetXminAge() int64 {
+	if x != nil {
+		return x.XminAge
+	}
+	return 0
+}
+
+var File_wraparound_report_proto protoreflect.FileDescriptor
+
+var file_wraparound_report_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x77, 0x72, 0x61, 0x70, 0x61, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x70, 0x67, 0x61, 0x6e, 0x61,
+	0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x0c,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8b, 0x0b, 0x0a,
+	0x14, 0x57, 0x72, 0x61, 0x70, 0x61, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x57, 0x0a, 0x13, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x12, 0x64, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x57,
+	0x0a, 0x13, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x67,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x12, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x61, 0x75, 0x74, 0x6f, 0x76,
+	0x61, 0x63, 0x75, 0x75, 0x6d, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x5f, 0x6d, 0x61, 0x78,
+	0x5f, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x61, 0x75, 0x74, 0x6f,
+	0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x4d, 0x61, 0x78, 0x41,
+	0x67, 0x65, 0x12, 0x4c, 0x0a, 0x23, 0x61, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d,
+	0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x78, 0x61, 0x63, 0x74, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x7a,
+	0x65, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x1f, 0x61, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x78, 0x61, 0x63, 0x74, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x4d, 0x61, 0x78, 0x41, 0x67, 0x65,
+	0x12, 0x67, 0x0a, 0x15, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x32, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x57, 0x72, 0x61, 0x70, 0x61, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x14, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x67, 0x0a, 0x15, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61,
+	0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x57,
+	0x72, 0x61, 0x70, 0x61, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x14, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x58, 0x0a, 0x14, 0x78, 0x6d, 0x69, 0x6e, 0x5f, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x6f, 0x6e, 0x5f, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x58, 0x6d, 0x69, 0x6e, 0x48, 0x6f, 0x72, 0x69, 0x7a,
+	0x6f, 0x6e, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x12, 0x78, 0x6d, 0x69, 0x6e, 0x48, 0x6f,
+	0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x2d, 0x0a, 0x13,
+	0x68, 0x61, 0x73, 0x5f, 0x78, 0x69, 0x64, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x68, 0x61, 0x73, 0x58, 0x69,
+	0x64, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x78,
+	0x69, 0x64, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x1f,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x78, 0x69, 0x64, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x12, 0x52, 0x0a, 0x26, 0x68, 0x61, 0x73, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x65, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e,
+	0x63, 0x79, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x18, 0x20, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x22, 0x68, 0x61, 0x73, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x55,
+	0x6e, 0x74, 0x69, 0x6c, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x41, 0x75, 0x74,
+	0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x12, 0x4b, 0x0a, 0x22, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x65, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e,
+	0x63, 0x79, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x18, 0x21, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x1f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x55, 0x6e, 0x74, 0x69,
+	0x6c, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x41, 0x75, 0x74, 0x6f, 0x76, 0x61,
+	0x63, 0x75, 0x75, 0x6d, 0x12, 0x3f, 0x0a, 0x1c, 0x68, 0x61, 0x73, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x77, 0x72, 0x61, 0x70, 0x61, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x22, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x68, 0x61, 0x73, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x57, 0x72, 0x61, 0x70, 0x61,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x77, 0x72, 0x61, 0x70, 0x61, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x18, 0x23, 0x20, 0x01, 0x28, 0x01, 0x52, 0x16, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x55, 0x6e, 0x74, 0x69, 0x6c, 0x57, 0x72, 0x61, 0x70, 0x61, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12,
+	0x2f, 0x0a, 0x14, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x78, 0x69, 0x64, 0x73, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x24, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x68,
+	0x61, 0x73, 0x4d, 0x78, 0x69, 0x64, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x12, 0x28, 0x0a, 0x10, 0x6d, 0x78, 0x69, 0x64, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x18, 0x25, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x6d, 0x78, 0x69, 0x64,
+	0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x5b, 0x0a, 0x2b, 0x68, 0x61,
+	0x73, 0x5f, 0x6d, 0x78, 0x69, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x5f, 0x75,
+	0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x65, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x61,
+	0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x18, 0x26, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x26, 0x68, 0x61, 0x73, 0x4d, 0x78, 0x69, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x55,
+	0x6e, 0x74, 0x69, 0x6c, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x41, 0x75, 0x74,
+	0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x12, 0x54, 0x0a, 0x27, 0x6d, 0x78, 0x69, 0x64, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x65, 0x6d,
+	0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75,
+	0x75, 0x6d, 0x18, 0x27, 0x20, 0x01, 0x28, 0x01, 0x52, 0x23, 0x6d, 0x78, 0x69, 0x64, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65,
+	0x6e, 0x63, 0x79, 0x41, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x12, 0x48, 0x0a,
+	0x21, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x78, 0x69, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x77, 0x72, 0x61, 0x70, 0x61, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x18, 0x28, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1d, 0x68, 0x61, 0x73, 0x4d, 0x78, 0x69,
+	0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x57, 0x72, 0x61,
+	0x70, 0x61, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x41, 0x0a, 0x1d, 0x6d, 0x78, 0x69, 0x64, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x77, 0x72,
+	0x61, 0x70, 0x61, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x29, 0x20, 0x01, 0x28, 0x01, 0x52, 0x1a,
+	0x6d, 0x78, 0x69, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x55, 0x6e, 0x74, 0x69, 0x6c,
+	0x57, 0x72, 0x61, 0x70, 0x61, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x98, 0x01, 0x0a, 0x1d, 0x57,
+	0x72, 0x61, 0x70, 0x61, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x64, 0x78, 0x12,
+	0x17, 0x0a, 0x07, 0x78, 0x69, 0x64, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x78, 0x69, 0x64, 0x41, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x61, 0x73, 0x5f,
+	0x6d, 0x78, 0x69, 0x64, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x68, 0x61, 0x73, 0x4d, 0x78, 0x69, 0x64, 0x41, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x78,
+	0x69, 0x64, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x78,
+	0x69, 0x64, 0x41, 0x67, 0x65, 0x22, 0xee, 0x01, 0x0a, 0x1d, 0x57, 0x72, 0x61, 0x70, 0x61, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73,
+	0x5f, 0x74, 0x6f, 0x61, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73,
+	0x54, 0x6f, 0x61, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x78, 0x69, 0x64, 0x5f, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x78, 0x69, 0x64, 0x41, 0x67, 0x65, 0x12, 0x20,
+	0x0a, 0x0c, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x78, 0x69, 0x64, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x4d, 0x78, 0x69, 0x64, 0x41, 0x67, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x6d, 0x78, 0x69, 0x64, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x6d, 0x78, 0x69, 0x64, 0x41, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x19, 0x61,
+	0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65,
+	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16,
+	0x61, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65,
+	0x4d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x22, 0xf2, 0x02, 0x0a, 0x11, 0x58, 0x6d, 0x69, 0x6e, 0x48,
+	0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x70, 0x67, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x2e, 0x58, 0x6d, 0x69, 0x6e, 0x48, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x48, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64,
+	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x49, 0x64, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x41, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a,
+	0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4e, 0x75, 0x6c, 0x6c,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x78, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x67, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x78, 0x6d, 0x69, 0x6e, 0x41, 0x67, 0x65, 0x22,
+	0x6a, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x52, 0x45, 0x50, 0x41, 0x52, 0x45,
+	0x44, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12,
+	0x14, 0x0a, 0x10, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
+	0x4c, 0x4f, 0x54, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x54, 0x41, 0x4e, 0x44, 0x42, 0x59,
+	0x5f, 0x46, 0x45, 0x45, 0x44, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x04, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_wraparound_report_proto_rawDescOnce sync.Once
+	file_wraparound_report_proto_rawDescData = file_wraparound_report_proto_rawDesc
+)
+
+func file_wraparound_report_proto_rawDescGZIP() []byte {
+	file_wraparound_report_proto_rawDescOnce.Do(func() {
+		file_wraparound_report_proto_rawDescData = protoimpl.X.CompressGZIP(file_wraparound_report_proto_rawDescData)
+	})
+	return file_wraparound_report_proto_rawDescData
+}
+
+var file_wraparound_report_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_wraparound_report_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_wraparound_report_proto_goTypes = []interface{}{
+	(XminHorizonHolder_Type)(0),           // 0: pganalyze.collector.XminHorizonHolder.Type
+	(*WraparoundReportData)(nil),          // 1: pganalyze.collector.WraparoundReportData
+	(*WraparoundDatabaseInformation)(nil), // 2: pganalyze.collector.WraparoundDatabaseInformation
+	(*WraparoundRelationInformation)(nil), // 3: pganalyze.collector.WraparoundRelationInformation
+	(*XminHorizonHolder)(nil),             // 4: pganalyze.collector.XminHorizonHolder
+	(*DatabaseReference)(nil),             // 5: pganalyze.collector.DatabaseReference
+	(*RelationReference)(nil),             // 6: pganalyze.collector.RelationReference
+	(*NullTimestamp)(nil),                 // 7: pganalyze.collector.NullTimestamp
+}
+var file_wraparound_report_proto_depIdxs = []int32{
+	5, // 0: pganalyze.collector.WraparoundReportData.database_references:type_name -> pganalyze.collector.DatabaseReference
+	6, // 1: pganalyze.collector.WraparoundReportData.relation_references:type_name -> pganalyze.collector.RelationReference
+	2, // 2: pganalyze.collector.WraparoundReportData.database_informations:type_name -> pganalyze.collector.WraparoundDatabaseInformation
+	3, // 3: pganalyze.collector.WraparoundReportData.relation_informations:type_name -> pganalyze.collector.WraparoundRelationInformation
+	4, // 4: pganalyze.collector.WraparoundReportData.xmin_horizon_holders:type_name -> pganalyze.collector.XminHorizonHolder
+	0, // 5: pganalyze.collector.XminHorizonHolder.type:type_name -> pganalyze.collector.XminHorizonHolder.Type
+	7, // 6: pganalyze.collector.XminHorizonHolder.started_at:type_name -> pganalyze.collector.NullTimestamp
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_wraparound_report_proto_init() }
+func file_wraparound_report_proto_init() {
+	if File_wraparound_report_proto != nil {
+		return
+	}
+	file_shared_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_wraparound_report_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WraparoundReportData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wraparound_report_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WraparoundDatabaseInformation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wraparound_report_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WraparoundRelationInformation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wraparound_report_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*XminHorizonHolder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_wraparound_report_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wraparound_report_proto_goTypes,
+		DependencyIndexes: file_wraparound_report_proto_depIdxs,
+		EnumInfos:         file_wraparound_report_proto_enumTypes,
+		MessageInfos:      file_wraparound_report_proto_msgTypes,
+	}.Build()
+	File_wraparound_report_proto = out.File
+	file_wraparound_report_proto_rawDesc = nil
+	file_wraparound_report_proto_goTypes = nil
+	file_wraparound_report_proto_depIdxs = nil
+}
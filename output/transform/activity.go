@@ -1,6 +1,8 @@
 package transform
 
 import (
+	"time"
+
 	"github.com/golang/protobuf/ptypes"
 	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
 	"github.com/pganalyze/collector/state"
@@ -106,5 +108,74 @@ func ActivityStateToCompactActivitySnapshot(server *state.Server, activityState
 		}
 	}
 
+	for _, progress := range activityState.Progress {
+		progressInfo := snapshot.ProgressInformation{
+			ProgressIdentity: progress.ProgressIdentity,
+			BackendIdentity:  progress.BackendIdentity,
+			CommandDetail:    progress.CommandDetail,
+			IndexName:        progress.IndexName,
+		}
+
+		switch progress.Command {
+		case state.ProgressCommandCreateIndex:
+			progressInfo.Command = snapshot.ProgressInformation_CREATE_INDEX
+		case state.ProgressCommandAnalyze:
+			progressInfo.Command = snapshot.ProgressInformation_ANALYZE
+		case state.ProgressCommandCluster:
+			progressInfo.Command = snapshot.ProgressInformation_CLUSTER
+		case state.ProgressCommandCopy:
+			progressInfo.Command = snapshot.ProgressInformation_COPY
+		case state.ProgressCommandBaseBackup:
+			progressInfo.Command = snapshot.ProgressInformation_BASE_BACKUP
+		}
+
+		if progress.RoleName != "" {
+			progressInfo.RoleIdx, r.RoleReferences = upsertRoleReference(r.RoleReferences, progress.RoleName)
+		} else {
+			progressInfo.RoleIdx = -1
+		}
+
+		if progress.DatabaseName != "" {
+			progressInfo.DatabaseIdx, r.DatabaseReferences = upsertDatabaseReference(r.DatabaseReferences, progress.DatabaseName)
+		} else {
+			progressInfo.DatabaseIdx = -1
+		}
+		if progress.RelationName != "" && progressInfo.DatabaseIdx != -1 {
+			relationRef := snapshot.RelationReference{
+				DatabaseIdx:  progressInfo.DatabaseIdx,
+				SchemaName:   progress.SchemaName,
+				RelationName: progress.RelationName,
+			}
+			progressInfo.RelationIdx = int32(len(r.RelationReferences))
+			r.RelationReferences = append(r.RelationReferences, &relationRef)
+		} else {
+			progressInfo.RelationIdx = -1
+		}
+
+		progressInfo.StartedAt, _ = ptypes.TimestampProto(progress.StartedAt)
+
+		s.ProgressInformations = append(s.ProgressInformations, &progressInfo)
+
+		progressStats := snapshot.ProgressStatistic{
+			ProgressIdentity: progress.ProgressIdentity,
+			Phase:            progress.Phase,
+			BlocksTotal:      progress.BlocksTotal,
+			BlocksDone:       progress.BlocksDone,
+			TuplesTotal:      progress.TuplesTotal,
+			TuplesDone:       progress.TuplesDone,
+			BytesTotal:       progress.BytesTotal,
+			BytesDone:        progress.BytesDone,
+		}
+		if !progress.PhaseObservedAt.IsZero() {
+			progressStats.PhaseObservedAt, _ = ptypes.TimestampProto(progress.PhaseObservedAt)
+		}
+		if remaining, ok := progress.EstimatedTimeRemaining(activityState.CollectedAt); ok {
+			progressStats.HasPhaseSecondsRemaining = true
+			progressStats.PhaseSecondsRemaining = int64(remaining / time.Second)
+		}
+
+		s.ProgressStatistics = append(s.ProgressStatistics, &progressStats)
+	}
+
 	return s, r
 }
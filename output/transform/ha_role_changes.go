@@ -0,0 +1,35 @@
+package transform
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformHARoleChanges(s snapshot.FullSnapshot, diffState state.DiffState) snapshot.FullSnapshot {
+	for _, change := range diffState.HARoleChanges {
+		s.HaRoleChanges = append(s.HaRoleChanges, HARoleChangeToProto(change))
+	}
+
+	return s
+}
+
+// HARoleChangeToProto - Converts a role change of a high availability cluster to its
+// protocol buffers representation
+func HARoleChangeToProto(change state.HARoleChange) *snapshot.HARoleChange {
+	c := snapshot.HARoleChange{
+		Role:           change.Role,
+		PreviousMember: change.PreviousMember,
+		NewMember:      change.NewMember,
+	}
+	c.DetectedAt, _ = ptypes.TimestampProto(change.DetectedAt)
+	for _, member := range change.Members {
+		c.Members = append(c.Members, &snapshot.HARoleChange_Member{
+			Name: member.Name,
+			Host: member.Host,
+			Port: int32(member.Port),
+			Role: member.Role,
+		})
+	}
+	return &c
+}
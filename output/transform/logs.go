@@ -105,6 +105,11 @@ func transformPostgresQuerySamples(server *state.Server, s snapshot.CompactLogSn
 			ExplainFormat: sampleIn.ExplainFormat,
 			ExplainOutput: sampleIn.ExplainOutput,
 			ExplainError:  sampleIn.ExplainError,
+
+			PlanFingerprint:         sampleIn.PlanFingerprint,
+			PlanNodes:               sampleIn.PlanNodes,
+			PlanChanged:             sampleIn.PlanChanged,
+			PreviousPlanFingerprint: sampleIn.PreviousPlanFingerprint,
 		}
 		s.QuerySamples = append(s.QuerySamples, &sample)
 	}
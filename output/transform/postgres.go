@@ -1,6 +1,7 @@
 package transform
 
 import (
+	"github.com/guregu/null"
 	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
 	"github.com/pganalyze/collector/state"
 )
@@ -12,12 +13,16 @@ func transformPostgres(s snapshot.FullSnapshot, newState state.PersistedState, d
 	s, databaseOidToIdx := transformPostgresDatabases(s, transientState, roleOidToIdx)
 
 	s = transformPostgresVersion(s, transientState)
-	s = transformPostgresConfig(s, transientState)
+	s = transformPostgresConfig(s, newState, diffState, transientState)
 	s = transformPostgresReplication(s, transientState, roleOidToIdx)
 	s = transformPostgresStatements(s, newState, diffState, transientState, roleOidToIdx, databaseOidToIdx)
 	s = transformPostgresRelations(s, newState, diffState, roleOidToIdx, databaseOidToIdx)
 	s = transformPostgresFunctions(s, newState, diffState, roleOidToIdx, databaseOidToIdx)
+	s = transformPostgresEventTriggers(s, newState, transientState, roleOidToIdx, databaseOidToIdx)
+	s = transformPostgresSchemaChanges(s, diffState, databaseOidToIdx)
+	s = transformPostgresExtensions(s, newState, databaseOidToIdx)
 	s = transformPostgresBackendCounts(s, transientState, roleOidToIdx, databaseOidToIdx)
+	s = transformPostgresSecurity(s, transientState, roleOidToIdx, databaseOidToIdx)
 
 	return s
 }
@@ -45,6 +50,7 @@ func transformPostgresRoles(s snapshot.FullSnapshot, transientState state.Transi
 			ConnectionLimit:    role.ConnectionLimit,
 			PasswordValidUntil: snapshot.NullTimeToNullTimestamp(role.PasswordValidUntil),
 			Config:             role.Config,
+			PasswordMethod:     transientState.Security.PasswordMethods[role.Oid],
 		}
 
 		for _, oid := range role.MemberOf {
@@ -96,8 +102,8 @@ func transformPostgresDatabases(s snapshot.FullSnapshot, transientState state.Tr
 	return s, databaseOidToIdx
 }
 
-func transformPostgresConfig(s snapshot.FullSnapshot, transientState state.TransientState) snapshot.FullSnapshot {
-	for _, setting := range transientState.Settings {
+func transformPostgresConfig(s snapshot.FullSnapshot, newState state.PersistedState, diffState state.DiffState, transientState state.TransientState) snapshot.FullSnapshot {
+	for _, setting := range newState.Settings {
 		info := snapshot.Setting{Name: setting.Name}
 
 		if setting.CurrentValue.Valid {
@@ -121,10 +127,32 @@ func transformPostgresConfig(s snapshot.FullSnapshot, transientState state.Trans
 		if setting.SourceLine.Valid {
 			info.SourceLine = &snapshot.NullString{Valid: true, Value: setting.SourceLine.String}
 		}
+		info.PendingRestart = setting.PendingRestart
 
 		s.Settings = append(s.Settings, &info)
 	}
 
+	for _, change := range diffState.SettingChanges {
+		s.SettingChanges = append(s.SettingChanges, &snapshot.SettingChange{
+			Name:           change.Name,
+			PreviousValue:  nullStringToSnapshot(change.PreviousValue),
+			CurrentValue:   nullStringToSnapshot(change.CurrentValue),
+			Unit:           nullStringToSnapshot(change.Unit),
+			SourceFile:     nullStringToSnapshot(change.SourceFile),
+			SourceLine:     nullStringToSnapshot(change.SourceLine),
+			PendingRestart: change.PendingRestart,
+		})
+	}
+
+	for _, fileError := range transientState.SettingFileErrors {
+		s.SettingFileErrors = append(s.SettingFileErrors, &snapshot.SettingFileError{
+			SourceFile: fileError.SourceFile,
+			SourceLine: fileError.SourceLine,
+			Name:       nullStringToSnapshot(fileError.Name),
+			Error:      fileError.Error,
+		})
+	}
+
 	return s
 }
 
@@ -136,3 +164,10 @@ func transformPostgresVersion(s snapshot.FullSnapshot, transientState state.Tran
 	}
 	return s
 }
+
+func nullStringToSnapshot(str null.String) *snapshot.NullString {
+	if !str.Valid {
+		return nil
+	}
+	return &snapshot.NullString{Valid: true, Value: str.String}
+}
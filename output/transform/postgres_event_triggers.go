@@ -0,0 +1,30 @@
+package transform
+
+import (
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformPostgresEventTriggers(s snapshot.FullSnapshot, newState state.PersistedState, transientState state.TransientState, roleOidToIdx OidToIdx, databaseOidToIdx OidToIdx) snapshot.FullSnapshot {
+	roleNameToIdx := makeRoleNameToIdx(transientState, roleOidToIdx)
+
+	for _, eventTrigger := range newState.EventTriggers {
+		ownerRoleIdx, ok := roleNameToIdx[eventTrigger.Owner]
+		if !ok {
+			ownerRoleIdx = -1
+		}
+		info := snapshot.EventTriggerInformation{
+			DatabaseIdx:    databaseOidToIdx[eventTrigger.DatabaseOid],
+			Name:           eventTrigger.Name,
+			Event:          eventTrigger.Event,
+			OwnerRoleIdx:   ownerRoleIdx,
+			FunctionSchema: eventTrigger.FunctionSchema,
+			FunctionName:   eventTrigger.FunctionName,
+			Enabled:        eventTrigger.Enabled,
+			Tags:           eventTrigger.Tags,
+		}
+		s.EventTriggerInformations = append(s.EventTriggerInformations, &info)
+	}
+
+	return s
+}
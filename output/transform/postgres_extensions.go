@@ -0,0 +1,25 @@
+package transform
+
+import (
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformPostgresExtensions(s snapshot.FullSnapshot, newState state.PersistedState, databaseOidToIdx OidToIdx) snapshot.FullSnapshot {
+	for _, extension := range newState.Extensions {
+		info := snapshot.ExtensionInformation{
+			DatabaseIdx:       databaseOidToIdx[extension.DatabaseOid],
+			ExtensionName:     extension.ExtensionName,
+			SchemaName:        extension.SchemaName,
+			Version:           extension.Version,
+			AvailableVersions: extension.AvailableVersions,
+			Outdated:          extension.Outdated(),
+		}
+		if extension.DefaultVersion.Valid {
+			info.DefaultVersion = &snapshot.NullString{Valid: true, Value: extension.DefaultVersion.String}
+		}
+		s.ExtensionInformations = append(s.ExtensionInformations, &info)
+	}
+
+	return s
+}
@@ -76,6 +76,17 @@ func transformPostgresRelations(s snapshot.FullSnapshot, newState state.Persiste
 			if column.DefaultValue.Valid {
 				sColumn.DefaultValue = &snapshot.NullString{Valid: true, Value: column.DefaultValue.String}
 			}
+			for _, stats := range column.Stats {
+				sStats := snapshot.ColumnStatistic{
+					Inherited:      stats.Inherited,
+					NullFrac:       stats.NullFrac,
+					AvgWidth:       stats.AvgWidth,
+					NDistinct:      stats.NDistinct,
+					HasCorrelation: stats.Correlation.Valid,
+					Correlation:    stats.Correlation.Float64,
+				}
+				sColumn.Statistics = append(sColumn.Statistics, &sStats)
+			}
 			info.Columns = append(info.Columns, &sColumn)
 		}
 		for _, constraint := range relation.Constraints {
@@ -98,6 +109,43 @@ func transformPostgresRelations(s snapshot.FullSnapshot, newState state.Persiste
 			}
 			info.Constraints = append(info.Constraints, &sConstraint)
 		}
+		for _, extendedStats := range relation.ExtendedStats {
+			sExtendedStats := snapshot.ExtendedStatistic{
+				SchemaName: extendedStats.SchemaName,
+				Name:       extendedStats.Name,
+				Definition: extendedStats.Definition,
+				Kinds:      extendedStats.Kinds,
+			}
+			if extendedStats.NDistinct.Valid {
+				sExtendedStats.NDistinct = &snapshot.NullString{Valid: true, Value: extendedStats.NDistinct.String}
+			}
+			if extendedStats.Dependencies.Valid {
+				sExtendedStats.Dependencies = &snapshot.NullString{Valid: true, Value: extendedStats.Dependencies.String}
+			}
+			info.ExtendedStatistics = append(info.ExtendedStatistics, &sExtendedStats)
+		}
+		for _, trigger := range relation.Triggers {
+			sTrigger := snapshot.TriggerInformation{
+				Name:           trigger.Name,
+				Definition:     trigger.Definition,
+				Enabled:        trigger.Enabled,
+				IsInternal:     trigger.IsInternal,
+				FunctionSchema: trigger.FunctionSchema,
+				FunctionName:   trigger.FunctionName,
+			}
+			info.Triggers = append(info.Triggers, &sTrigger)
+		}
+		for _, rule := range relation.Rules {
+			sRule := snapshot.RuleInformation{
+				Name:       rule.Name,
+				Definition: rule.Definition,
+				Event:      rule.Event,
+				IsInstead:  rule.IsInstead,
+				Enabled:    rule.Enabled,
+			}
+			info.Rules = append(info.Rules, &sRule)
+		}
+		info.Publications = relation.Publications
 		s.RelationInformations = append(s.RelationInformations, &info)
 
 		// Statistic
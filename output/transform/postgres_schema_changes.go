@@ -0,0 +1,60 @@
+package transform
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformPostgresSchemaChanges(s snapshot.FullSnapshot, diffState state.DiffState, databaseOidToIdx OidToIdx) snapshot.FullSnapshot {
+	for _, change := range diffState.SchemaChanges {
+		s.SchemaChanges = append(s.SchemaChanges, SchemaChangeToProto(change, databaseOidToIdx[change.DatabaseOid]))
+	}
+
+	return s
+}
+
+// SchemaChangeToProto - Converts a detected schema change to its protocol buffers
+// representation, referencing the database by the given index
+func SchemaChangeToProto(change state.PostgresSchemaChange, databaseIdx int32) *snapshot.SchemaChange {
+	var objectType snapshot.SchemaChange_ObjectType
+	switch change.ObjectType {
+	case state.SchemaObjectRelation:
+		objectType = snapshot.SchemaChange_RELATION
+	case state.SchemaObjectColumn:
+		objectType = snapshot.SchemaChange_COLUMN
+	case state.SchemaObjectIndex:
+		objectType = snapshot.SchemaChange_INDEX
+	case state.SchemaObjectConstraint:
+		objectType = snapshot.SchemaChange_CONSTRAINT
+	case state.SchemaObjectFunction:
+		objectType = snapshot.SchemaChange_FUNCTION
+	default:
+		objectType = snapshot.SchemaChange_UNKNOWN_OBJECT
+	}
+
+	var changeType snapshot.SchemaChange_ChangeType
+	switch change.ChangeType {
+	case state.SchemaChangeAdded:
+		changeType = snapshot.SchemaChange_ADDED
+	case state.SchemaChangeDropped:
+		changeType = snapshot.SchemaChange_DROPPED
+	case state.SchemaChangeAltered:
+		changeType = snapshot.SchemaChange_ALTERED
+	default:
+		changeType = snapshot.SchemaChange_UNKNOWN_CHANGE
+	}
+
+	detectedAt, _ := ptypes.TimestampProto(change.DetectedAt)
+
+	return &snapshot.SchemaChange{
+		DetectedAt:   detectedAt,
+		DatabaseIdx:  databaseIdx,
+		ObjectType:   objectType,
+		ChangeType:   changeType,
+		SchemaName:   change.SchemaName,
+		RelationName: change.RelationName,
+		ObjectName:   change.ObjectName,
+		Details:      change.Details,
+	}
+}
@@ -0,0 +1,103 @@
+package transform
+
+import (
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformPostgresSecurity(s snapshot.FullSnapshot, transientState state.TransientState, roleOidToIdx OidToIdx, databaseOidToIdx OidToIdx) snapshot.FullSnapshot {
+	security := transientState.Security
+
+	// Privileges and policies reference roles by name, since they are collected
+	// from each database separately
+	roleNameToIdx := makeRoleNameToIdx(transientState, roleOidToIdx)
+	roleIdx := func(name string) int32 {
+		idx, ok := roleNameToIdx[name]
+		if !ok {
+			return -1
+		}
+		return idx
+	}
+
+	for _, privilege := range security.Privileges {
+		var objectType snapshot.PrivilegeInformation_ObjectType
+		switch privilege.ObjectType {
+		case state.PrivilegeObjectTable:
+			objectType = snapshot.PrivilegeInformation_TABLE
+		case state.PrivilegeObjectSchema:
+			objectType = snapshot.PrivilegeInformation_SCHEMA
+		case state.PrivilegeObjectFunction:
+			objectType = snapshot.PrivilegeInformation_FUNCTION
+		default:
+			objectType = snapshot.PrivilegeInformation_UNKNOWN
+		}
+
+		info := snapshot.PrivilegeInformation{
+			DatabaseIdx:    databaseOidToIdx[privilege.DatabaseOid],
+			ObjectType:     objectType,
+			SchemaName:     privilege.SchemaName,
+			ObjectName:     privilege.ObjectName,
+			GrantorRoleIdx: roleIdx(privilege.Grantor),
+			GranteeRoleIdx: roleIdx(privilege.Grantee),
+			Privilege:      privilege.Privilege,
+			Grantable:      privilege.Grantable,
+		}
+		s.PrivilegeInformations = append(s.PrivilegeInformations, &info)
+	}
+
+	for _, membership := range security.RoleMemberships {
+		info := snapshot.RoleMembershipInformation{
+			RoleIdx:        roleOidToIdx[membership.RoleOid],
+			MemberRoleIdx:  roleOidToIdx[membership.MemberOid],
+			GrantorRoleIdx: roleOidToIdx[membership.GrantorOid],
+			AdminOption:    membership.AdminOption,
+		}
+		s.RoleMembershipInformations = append(s.RoleMembershipInformations, &info)
+	}
+
+	for _, policy := range security.Policies {
+		info := snapshot.PolicyInformation{
+			DatabaseIdx: databaseOidToIdx[policy.DatabaseOid],
+			SchemaName:  policy.SchemaName,
+			TableName:   policy.TableName,
+			Name:        policy.Name,
+			Command:     policy.Command,
+			Permissive:  policy.Permissive,
+			Using:       nullStringToSnapshot(policy.Using),
+			WithCheck:   nullStringToSnapshot(policy.WithCheck),
+		}
+		for _, role := range policy.Roles {
+			if role == "public" {
+				info.AppliesToPublic = true
+			} else if idx, ok := roleNameToIdx[role]; ok {
+				info.RoleIdxs = append(info.RoleIdxs, idx)
+			}
+		}
+		s.PolicyInformations = append(s.PolicyInformations, &info)
+	}
+
+	for _, rule := range security.HbaRules {
+		info := snapshot.HbaRuleInformation{
+			LineNumber: rule.LineNumber,
+			Type:       nullStringToSnapshot(rule.Type),
+			Databases:  rule.Databases,
+			UserNames:  rule.UserNames,
+			Address:    nullStringToSnapshot(rule.Address),
+			Netmask:    nullStringToSnapshot(rule.Netmask),
+			AuthMethod: nullStringToSnapshot(rule.AuthMethod),
+			Options:    rule.Options,
+			Error:      nullStringToSnapshot(rule.Error),
+		}
+		s.HbaRuleInformations = append(s.HbaRuleInformations, &info)
+	}
+
+	return s
+}
+
+func makeRoleNameToIdx(transientState state.TransientState, roleOidToIdx OidToIdx) map[string]int32 {
+	roleNameToIdx := make(map[string]int32)
+	for _, role := range transientState.Roles {
+		roleNameToIdx[role.Name] = roleOidToIdx[role.Oid]
+	}
+	return roleNameToIdx
+}
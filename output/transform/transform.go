@@ -13,6 +13,7 @@ func StateToSnapshot(newState state.PersistedState, diffState state.DiffState, t
 	s = transformCollectorStats(s, newState, diffState)
 	s = transformCollectorPlatform(s, transientState)
 	s = transformCollectorConfig(s, transientState)
+	s = transformHARoleChanges(s, diffState)
 
 	return s
 }
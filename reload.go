@@ -0,0 +1,212 @@
+package main
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/input/system/azure"
+	"github.com/pganalyze/collector/input/system/google_cloudsql"
+	"github.com/pganalyze/collector/input/system/selfhosted"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// collectorServers - Servers and their log processing, which are kept across configuration
+// reloads (SIGHUP) for all servers whose configuration did not change
+//
+// This preserves the in-memory state of these servers (e.g. the previous snapshot used for
+// diffs, and log lines still being stitched together), as well as their running log tails.
+//
+// Discovery applies its changes concurrently with reloads, so everything except current()
+// requires mutex to be held, which applyDiscoveredServers and stop take themselves.
+type collectorServers struct {
+	mutex sync.Mutex
+
+	servers      []*state.Server
+	serversMutex sync.Mutex // Servers can be replaced by discovery, while being collected
+
+	// Log tails of each server, stopped when the server is removed or changed
+	logTailCancels map[*state.Server]context.CancelFunc
+
+	// Pub/Sub and Event Hub subscribers are shared by all servers of the same type, and
+	// restarted when any of them was added, changed or removed
+	gcpCancel    context.CancelFunc
+	azureCancel  context.CancelFunc
+	gcpChanged   bool
+	azureChanged bool
+
+	// Heroku log drains are received by HTTP handlers, which can only be registered once
+	herokuStarted bool
+
+	// Servers found by discovery sections, kept when discovery fails after a reload
+	discovered []config.ServerConfig
+
+	logWg sync.WaitGroup
+}
+
+// update - Applies the server configurations read from the config file, and returns all
+// servers, as well as the ones that were added or changed (and need to be set up)
+func (c *collectorServers) update(configs []config.ServerConfig, logger *util.Logger) (servers []*state.Server, newServers []*state.Server) {
+	if c.logTailCancels == nil {
+		c.logTailCancels = make(map[*state.Server]context.CancelFunc)
+	}
+
+	previous := make(map[string]*state.Server)
+	for _, server := range c.servers {
+		previous[server.Config.SectionName] = server
+	}
+	isFirstRun := c.servers == nil
+
+	c.gcpChanged = false
+	c.azureChanged = false
+	for _, conf := range configs {
+		server, exists := previous[conf.SectionName]
+		if exists && server.Config.Equal(conf) {
+			delete(previous, conf.SectionName)
+			servers = append(servers, server)
+			continue
+		}
+
+		server = &state.Server{Config: conf, StateMutex: &sync.Mutex{}, LogStateMutex: &sync.Mutex{}, ActivityStateMutex: &sync.Mutex{}, QueryPlansMutex: &sync.Mutex{}, CollectionStatusMutex: &sync.Mutex{}, HealthMutex: &sync.Mutex{}, HAMutex: &sync.Mutex{}}
+		servers = append(servers, server)
+		newServers = append(newServers, server)
+		c.markChanged(conf)
+		if !isFirstRun {
+			if exists {
+				logger.WithPrefix(conf.SectionName).PrintInfo("Configuration changed, restarting collection for this server")
+			} else {
+				logger.WithPrefix(conf.SectionName).PrintInfo("Server added")
+			}
+		}
+	}
+
+	// Anything left over was either removed, or has been replaced due to a changed configuration
+	removedCount := 0
+	for _, server := range previous {
+		c.stopLogTail(server)
+		c.markChanged(server.Config)
+		if !isExistingSection(configs, server.Config.SectionName) {
+			logger.WithPrefix(server.Config.SectionName).PrintInfo("Server removed")
+			removedCount++
+		}
+	}
+
+	if !isFirstRun {
+		logger.PrintVerbose("Reloaded configuration: %d server(s) unchanged, %d added or changed, %d removed", len(servers)-len(newServers), len(newServers), removedCount)
+	}
+
+	c.serversMutex.Lock()
+	c.servers = servers
+	c.serversMutex.Unlock()
+	return servers, newServers
+}
+
+// current - Returns the servers to collect from, including any changes in discovered servers
+func (c *collectorServers) current() []*state.Server {
+	c.serversMutex.Lock()
+	defer c.serversMutex.Unlock()
+	return c.servers
+}
+
+// applyDiscoveredServers - Applies changes in discovered servers (e.g. a new RDS instance)
+// without a full reload, keeping all other servers and their log processing as they are
+//
+// Nothing is applied if ctx was cancelled, since the configuration is being reloaded.
+func (c *collectorServers) applyDiscoveredServers(ctx context.Context, configuredServers []config.ServerConfig, discovered []config.ServerConfig, globalCollectionOpts state.CollectionOpts, logger *util.Logger) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if ctx.Err() != nil {
+		return
+	}
+	c.discovered = discovered
+	configs := append(append([]config.ServerConfig{}, configuredServers...), discovered...)
+	_, newServers := c.update(configs, logger)
+	state.ReadStateFile(newServers, globalCollectionOpts, logger)
+	c.setupLogTails(newServers, globalCollectionOpts, logger)
+}
+
+func (c *collectorServers) markChanged(conf config.ServerConfig) {
+	if conf.SystemType == "google_cloudsql" {
+		c.gcpChanged = true
+	}
+	if conf.SystemType == "azure_database" {
+		c.azureChanged = true
+	}
+}
+
+func isExistingSection(configs []config.ServerConfig, sectionName string) bool {
+	for _, conf := range configs {
+		if conf.SectionName == sectionName {
+			return true
+		}
+	}
+	return false
+}
+
+// setupLogTails - Starts the log tails for new servers, which keep running across reloads
+func (c *collectorServers) setupLogTails(newServers []*state.Server, globalCollectionOpts state.CollectionOpts, logger *util.Logger) {
+	for _, server := range newServers {
+		if server.Config.DisableLogs || (server.Config.LogLocation == "" && server.Config.LogDockerTail == "") {
+			continue
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		c.logTailCancels[server] = cancel
+		selfhosted.SetupLogTails(ctx, []*state.Server{server}, globalCollectionOpts, logger)
+	}
+}
+
+func (c *collectorServers) stopLogTail(server *state.Server) {
+	if cancel, ok := c.logTailCancels[server]; ok {
+		cancel()
+		delete(c.logTailCancels, server)
+	}
+}
+
+// setupGoogleCloudSQLLogs - Starts the Pub/Sub subscriber (or restarts it, if any Cloud SQL
+// server changed), or stops it if no server uses it anymore
+func (c *collectorServers) setupGoogleCloudSQLLogs(enabled bool, globalCollectionOpts state.CollectionOpts, logger *util.Logger) {
+	if c.gcpCancel != nil && (!enabled || c.gcpChanged) {
+		c.gcpCancel()
+		c.gcpCancel = nil
+	}
+	if !enabled || c.gcpCancel != nil {
+		return
+	}
+
+	var ctx context.Context
+	ctx, c.gcpCancel = context.WithCancel(context.Background())
+	gcpLogStream := make(chan google_cloudsql.LogStreamItem, streamBufferLen)
+	google_cloudsql.SetupLogSubscriber(ctx, &c.logWg, globalCollectionOpts, logger, c.servers, gcpLogStream)
+	google_cloudsql.SetupLogReceiver(ctx, c.servers, globalCollectionOpts, logger, gcpLogStream)
+}
+
+// setupAzureLogs - Starts the Event Hub subscriber (or restarts it, if any Azure server
+// changed), or stops it if no server uses it anymore
+func (c *collectorServers) setupAzureLogs(enabled bool, globalCollectionOpts state.CollectionOpts, logger *util.Logger) {
+	if c.azureCancel != nil && (!enabled || c.azureChanged) {
+		c.azureCancel()
+		c.azureCancel = nil
+	}
+	if !enabled || c.azureCancel != nil {
+		return
+	}
+
+	var ctx context.Context
+	ctx, c.azureCancel = context.WithCancel(context.Background())
+	azureLogStream := make(chan azure.AzurePostgresLogRecord, streamBufferLen)
+	azure.SetupLogSubscriber(ctx, &c.logWg, globalCollectionOpts, logger, c.servers, azureLogStream)
+	azure.SetupLogReceiver(ctx, c.servers, globalCollectionOpts, logger, azureLogStream)
+}
+
+// stop - Stops all log processing, when the collector exits
+func (c *collectorServers) stop() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for server := range c.logTailCancels {
+		c.stopLogTail(server)
+	}
+	c.setupGoogleCloudSQLLogs(false, state.CollectionOpts{}, nil)
+	c.setupAzureLogs(false, state.CollectionOpts{}, nil)
+	c.logWg.Wait()
+}
@@ -2,6 +2,7 @@ package reports
 
 import (
 	"database/sql"
+	"sort"
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
@@ -29,7 +30,7 @@ func (report BloatReport) ReportType() string {
 }
 
 // Run the report
-func (report *BloatReport) Run(server *state.Server, logger *util.Logger, connection *sql.DB) (err error) {
+func (report *BloatReport) Run(server *state.Server, logger *util.Logger, connection *sql.DB, globalCollectionOpts state.CollectionOpts) (err error) {
 	systemType := server.Config.SystemType
 
 	report.Data, err = postgres.GetBloatStats(logger, connection, systemType, server.Config.IgnoreSchemaRegexp)
@@ -42,22 +43,43 @@ func (report *BloatReport) Run(server *state.Server, logger *util.Logger, connec
 
 // Result of the report
 func (report *BloatReport) Result() *pganalyze_collector.Report {
+	return bloatReportResult(report.ReportRunID, report.ReportType(), report.CollectedAt, report.Data)
+}
+
+func bloatReportResult(reportRunID string, reportType string, collectedAt time.Time, stats state.PostgresBloatStats) *pganalyze_collector.Report {
 	var r pganalyze_collector.Report
 	var data pganalyze_collector.BloatReportData
 
-	r.ReportRunId = report.ReportRunID
-	r.ReportType = "bloat"
-	r.CollectedAt, _ = ptypes.TimestampProto(report.CollectedAt)
+	r.ReportRunId = reportRunID
+	r.ReportType = reportType
+	r.CollectedAt, _ = ptypes.TimestampProto(collectedAt)
 
-	data.DatabaseReferences = append(data.DatabaseReferences, &pganalyze_collector.DatabaseReference{Name: report.Data.DatabaseName})
+	data.DatabaseReferences = append(data.DatabaseReferences, &pganalyze_collector.DatabaseReference{Name: stats.DatabaseName})
 
-	for _, relation := range report.Data.Relations {
-		data.RelationBloatStatistics = append(data.RelationBloatStatistics, &pganalyze_collector.RelationBloatStatistic{RelationIdx: int32(len(data.RelationReferences)), BloatLookupMethod: pganalyze_collector.BloatLookupMethod_ESTIMATE_FAST, TotalBytes: relation.TotalBytes, BloatBytes: relation.BloatBytes})
+	for _, relation := range stats.Relations {
+		statistic := pganalyze_collector.RelationBloatStatistic{RelationIdx: int32(len(data.RelationReferences)), BloatLookupMethod: pganalyze_collector.BloatLookupMethod_ESTIMATE_FAST, TotalBytes: relation.TotalBytes, BloatBytes: relation.BloatBytes}
+		if relation.Exact {
+			statistic.BloatLookupMethod = pganalyze_collector.BloatLookupMethod_ESTIMATE_SLOW
+			statistic.LiveTupleBytes = relation.LiveTupleBytes
+			statistic.LiveTupleCount = relation.LiveTupleCount
+			statistic.DeadTupleBytes = relation.DeadTupleBytes
+			statistic.DeadTupleCount = relation.DeadTupleCount
+		}
+		data.RelationBloatStatistics = append(data.RelationBloatStatistics, &statistic)
 		data.RelationReferences = append(data.RelationReferences, &pganalyze_collector.RelationReference{DatabaseIdx: 0, SchemaName: relation.SchemaName, RelationName: relation.RelationName})
 	}
 
-	for _, index := range report.Data.Indices {
-		data.IndexBloatStatistics = append(data.IndexBloatStatistics, &pganalyze_collector.IndexBloatStatistic{IndexIdx: int32(len(data.IndexReferences)), TotalBytes: index.TotalBytes, BloatBytes: index.BloatBytes})
+	for _, index := range stats.Indices {
+		statistic := pganalyze_collector.IndexBloatStatistic{IndexIdx: int32(len(data.IndexReferences)), TotalBytes: index.TotalBytes, BloatBytes: index.BloatBytes}
+		if index.Exact {
+			statistic.BloatLookupMethod = pganalyze_collector.BloatLookupMethod_ESTIMATE_SLOW
+			statistic.InternalPages = index.InternalPages
+			statistic.LeafPages = index.LeafPages
+			statistic.EmptyPages = index.EmptyPages
+			statistic.DeletedPages = index.DeletedPages
+			statistic.AvgLeafDensity = index.AvgLeafDensity
+		}
+		data.IndexBloatStatistics = append(data.IndexBloatStatistics, &statistic)
 		data.IndexReferences = append(data.IndexReferences, &pganalyze_collector.IndexReference{DatabaseIdx: 0, SchemaName: index.SchemaName, IndexName: index.IndexName})
 	}
 
@@ -65,3 +87,34 @@ func (report *BloatReport) Result() *pganalyze_collector.Report {
 
 	return &r
 }
+
+// Table - Returns tables and indexes with the most bloat first
+func (report *BloatReport) Table() ([]string, [][]string) {
+	return bloatTable(report.Data)
+}
+
+func bloatTable(stats state.PostgresBloatStats) ([]string, [][]string) {
+	type bloatRow struct {
+		kind       string
+		schemaName string
+		name       string
+		totalBytes int64
+		bloatBytes int64
+	}
+	var bloatRows []bloatRow
+	for _, relation := range stats.Relations {
+		bloatRows = append(bloatRows, bloatRow{"table", relation.SchemaName, relation.RelationName, relation.TotalBytes, relation.BloatBytes})
+	}
+	for _, index := range stats.Indices {
+		bloatRows = append(bloatRows, bloatRow{"index", index.SchemaName, index.IndexName, index.TotalBytes, index.BloatBytes})
+	}
+	sort.SliceStable(bloatRows, func(i, j int) bool {
+		return bloatRows[i].bloatBytes > bloatRows[j].bloatBytes
+	})
+
+	var rows [][]string
+	for _, r := range bloatRows {
+		rows = append(rows, []string{r.kind, r.schemaName, r.name, formatBytes(r.totalBytes), formatBytes(r.bloatBytes), formatPercent(float64(r.bloatBytes), float64(r.totalBytes))})
+	}
+	return []string{"TYPE", "SCHEMA", "NAME", "TOTAL SIZE", "BLOAT", "BLOAT %"}, rows
+}
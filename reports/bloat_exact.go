@@ -0,0 +1,52 @@
+package reports
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/pganalyze/collector/input/postgres"
+	"github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// BloatExactReport - Report on table and index bloat, measured using pgstattuple
+type BloatExactReport struct {
+	ReportRunID string
+	CollectedAt time.Time
+	Data        state.PostgresBloatStats
+}
+
+// RunID - Returns the ID of this report run
+func (report BloatExactReport) RunID() string {
+	return report.ReportRunID
+}
+
+// ReportType - Returns the type of the report as a string
+func (report BloatExactReport) ReportType() string {
+	return "bloat_exact"
+}
+
+// Run the report
+func (report *BloatExactReport) Run(server *state.Server, logger *util.Logger, connection *sql.DB, globalCollectionOpts state.CollectionOpts) (err error) {
+	maxSizeBytes := int64(server.Config.BloatExactMaxSizeMb) * 1024 * 1024
+	timeoutMs := int32(server.Config.BloatExactTimeoutMs)
+
+	report.Data, err = postgres.GetExactBloatStats(server, logger, connection, maxSizeBytes, timeoutMs)
+	if err != nil {
+		logger.PrintWarning("Could not measure exact bloat, using bloat estimate instead: %s", err)
+		report.Data, err = postgres.GetBloatStats(logger, connection, server.Config.SystemType, server.Config.IgnoreSchemaRegexp)
+	}
+
+	return
+}
+
+// Result of the report
+func (report *BloatExactReport) Result() *pganalyze_collector.Report {
+	return bloatReportResult(report.ReportRunID, report.ReportType(), report.CollectedAt, report.Data)
+}
+
+// Table - Returns tables and indexes with the most bloat first
+func (report *BloatExactReport) Table() ([]string, [][]string) {
+	return bloatTable(report.Data)
+}
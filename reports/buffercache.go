@@ -2,6 +2,7 @@ package reports
 
 import (
 	"database/sql"
+	"sort"
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
@@ -30,7 +31,7 @@ func (report BuffercacheReport) ReportType() string {
 }
 
 // Run the report
-func (report *BuffercacheReport) Run(server *state.Server, logger *util.Logger, connection *sql.DB) (err error) {
+func (report *BuffercacheReport) Run(server *state.Server, logger *util.Logger, connection *sql.DB, globalCollectionOpts state.CollectionOpts) (err error) {
 	systemType := server.Config.SystemType
 
 	report.Data, err = postgres.GetBuffercache(logger, connection, systemType, server.Config.IgnoreSchemaRegexp)
@@ -81,3 +82,29 @@ func (report *BuffercacheReport) Result() *pganalyze_collector.Report {
 
 	return &r
 }
+
+// Table - Returns the largest buffer cache consumers first
+func (report *BuffercacheReport) Table() ([]string, [][]string) {
+	entries := append([]state.PostgresBuffercacheEntry{}, report.Data.Entries...)
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Bytes > entries[j].Bytes
+	})
+
+	var rows [][]string
+	for _, entry := range entries {
+		var schemaName, objectName, objectKind string
+		if entry.SchemaName != nil {
+			schemaName = *entry.SchemaName
+		}
+		if entry.ObjectName != nil {
+			objectName = *entry.ObjectName
+		}
+		if entry.ObjectKind != nil {
+			objectKind = *entry.ObjectKind
+		}
+		rows = append(rows, []string{entry.DatabaseName, schemaName, objectName, objectKind, formatBool(entry.Toast),
+			formatBytes(entry.Bytes), formatPercent(float64(entry.Bytes), float64(report.Data.TotalBytes))})
+	}
+	rows = append(rows, []string{"", "", "(free)", "", "", formatBytes(report.Data.FreeBytes), formatPercent(float64(report.Data.FreeBytes), float64(report.Data.TotalBytes))})
+	return []string{"DATABASE", "SCHEMA", "OBJECT", "KIND", "TOAST", "SIZE", "% OF CACHE"}, rows
+}
@@ -0,0 +1,80 @@
+package reports
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/output/transform"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// HARoleChangesReport - Report on the role changes (e.g. failovers) the collector followed
+// for a high availability cluster
+type HARoleChangesReport struct {
+	ReportRunID string
+	CollectedAt time.Time
+	Data        []state.HARoleChange
+}
+
+// RunID - Returns the ID of this report run
+func (report HARoleChangesReport) RunID() string {
+	return report.ReportRunID
+}
+
+// ReportType - Returns the type of the report as a string
+func (report HARoleChangesReport) ReportType() string {
+	return "ha_role_changes"
+}
+
+// Run the report
+//
+// Role changes are recorded as part of the full snapshot, this returns the rolling
+// change log kept in the collector state, most recent change first.
+func (report *HARoleChangesReport) Run(server *state.Server, logger *util.Logger, connection *sql.DB, globalCollectionOpts state.CollectionOpts) (err error) {
+	server.StateMutex.Lock()
+	changeLog := server.PrevState.HARoleChanges
+	server.StateMutex.Unlock()
+
+	report.Data = make([]state.HARoleChange, 0, len(changeLog))
+	for i := len(changeLog) - 1; i >= 0; i-- {
+		report.Data = append(report.Data, changeLog[i])
+	}
+
+	return nil
+}
+
+// Result of the report
+func (report *HARoleChangesReport) Result() *pganalyze_collector.Report {
+	var r pganalyze_collector.Report
+	var data pganalyze_collector.HARoleChangesReportData
+
+	r.ReportRunId = report.ReportRunID
+	r.ReportType = report.ReportType()
+	r.CollectedAt, _ = ptypes.TimestampProto(report.CollectedAt)
+
+	for _, change := range report.Data {
+		data.HaRoleChanges = append(data.HaRoleChanges, transform.HARoleChangeToProto(change))
+	}
+
+	r.Data = &pganalyze_collector.Report_HaRoleChangesReportData{HaRoleChangesReportData: &data}
+
+	return &r
+}
+
+// Table - Returns the role changes, most recent first
+func (report *HARoleChangesReport) Table() ([]string, [][]string) {
+	var rows [][]string
+	for _, change := range report.Data {
+		var members []string
+		for _, member := range change.Members {
+			members = append(members, member.Address()+" "+member.Role)
+		}
+		rows = append(rows, []string{change.DetectedAt.Format("2006-01-02 15:04:05"), change.Role,
+			change.PreviousMember, change.NewMember, strings.Join(members, ", ")})
+	}
+	return []string{"DETECTED AT", "ROLE", "PREVIOUS MEMBER", "NEW MEMBER", "MEMBERS"}, rows
+}
@@ -0,0 +1,324 @@
+package reports
+
+import (
+	"database/sql"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pganalyze/collector/input/postgres"
+	"github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// Index scan counts are only meaningful once statistics have been gathered for a
+// while, otherwise indexes used by e.g. weekly jobs would show up as unused
+const indexHealthMinimumStatsAge = 7 * 24 * time.Hour
+
+// IndexHealthReport - Report on unused, duplicate and invalid indexes
+type IndexHealthReport struct {
+	ReportRunID string
+	CollectedAt time.Time
+	Data        state.PostgresIndexHealthReport
+}
+
+// RunID - Returns the ID of this report run
+func (report IndexHealthReport) RunID() string {
+	return report.ReportRunID
+}
+
+// ReportType - Returns the type of the report as a string
+func (report IndexHealthReport) ReportType() string {
+	return "index_health"
+}
+
+// Run the report
+func (report *IndexHealthReport) Run(server *state.Server, logger *util.Logger, connection *sql.DB, globalCollectionOpts state.CollectionOpts) (err error) {
+	version, err := postgres.GetPostgresVersion(logger, connection)
+	if err != nil {
+		return
+	}
+
+	databases, err := postgres.GetDatabases(logger, connection, version)
+	if err != nil {
+		return
+	}
+
+	for _, dbName := range postgres.SchemaDatabaseNames(server, databases, server.Config.SystemType) {
+		db, err := postgres.EstablishConnection(server, logger, globalCollectionOpts, dbName)
+		if err != nil {
+			logger.PrintWarning("Skipping index health of database %s, due to connection error: %s", dbName, err)
+			continue
+		}
+
+		err = report.runDatabase(db, dbName, version, server.Config.IgnoreSchemaRegexp)
+		db.Close()
+		if err != nil {
+			logger.PrintWarning("Skipping index health of database %s, due to error: %s", dbName, err)
+		}
+	}
+
+	for _, issue := range report.Data.Issues {
+		report.Data.TotalSizeBytes += issue.SizeBytes
+	}
+
+	return nil
+}
+
+func (report *IndexHealthReport) runDatabase(db *sql.DB, dbName string, version state.PostgresVersion, ignoreRegexp string) error {
+	databaseOid, err := postgres.CurrentDatabaseOid(db)
+	if err != nil {
+		return err
+	}
+
+	relations, err := postgres.GetRelations(db, version, databaseOid, ignoreRegexp)
+	if err != nil {
+		return err
+	}
+
+	indexStats, err := postgres.GetIndexStats(db, version, ignoreRegexp)
+	if err != nil {
+		return err
+	}
+
+	statsResetAt, err := postgres.CurrentDatabaseStatsResetAt(db)
+	if err != nil {
+		return err
+	}
+
+	database := state.PostgresIndexHealthDatabase{
+		DatabaseName:  dbName,
+		StatsResetAt:  statsResetAt,
+		CheckedUnused: !statsResetAt.Valid || report.CollectedAt.Sub(statsResetAt.Time) >= indexHealthMinimumStatsAge,
+	}
+	report.Data.Databases = append(report.Data.Databases, database)
+
+	for _, relation := range relations {
+		issues := findIndexHealthIssues(relation.Indices, indexStats, database.CheckedUnused)
+		for _, issue := range issues {
+			issue.DatabaseName = dbName
+			issue.SchemaName = relation.SchemaName
+			issue.RelationName = relation.RelationName
+			report.Data.Issues = append(report.Data.Issues, issue)
+		}
+	}
+
+	return nil
+}
+
+// Result of the report
+func (report *IndexHealthReport) Result() *pganalyze_collector.Report {
+	var r pganalyze_collector.Report
+	var data pganalyze_collector.IndexHealthReportData
+
+	r.ReportRunId = report.ReportRunID
+	r.ReportType = report.ReportType()
+	r.CollectedAt, _ = ptypes.TimestampProto(report.CollectedAt)
+
+	databaseNameToIdx := make(map[string]int32)
+	for _, database := range report.Data.Databases {
+		databaseNameToIdx[database.DatabaseName] = int32(len(data.DatabaseReferences))
+		data.DatabaseReferences = append(data.DatabaseReferences, &pganalyze_collector.DatabaseReference{Name: database.DatabaseName})
+
+		data.DatabaseInformations = append(data.DatabaseInformations, &pganalyze_collector.IndexHealthDatabaseInformation{
+			DatabaseIdx:   databaseNameToIdx[database.DatabaseName],
+			StatsResetAt:  pganalyze_collector.NullTimeToNullTimestamp(database.StatsResetAt),
+			CheckedUnused: database.CheckedUnused,
+		})
+	}
+
+	type relationKey struct {
+		databaseName string
+		schemaName   string
+		relationName string
+	}
+	relationToIdx := make(map[relationKey]int32)
+
+	for _, issue := range report.Data.Issues {
+		key := relationKey{issue.DatabaseName, issue.SchemaName, issue.RelationName}
+		relationIdx, exists := relationToIdx[key]
+		if !exists {
+			relationIdx = int32(len(data.RelationReferences))
+			relationToIdx[key] = relationIdx
+			data.RelationReferences = append(data.RelationReferences, &pganalyze_collector.RelationReference{
+				DatabaseIdx:  databaseNameToIdx[issue.DatabaseName],
+				SchemaName:   issue.SchemaName,
+				RelationName: issue.RelationName,
+			})
+		}
+
+		var issueType pganalyze_collector.IndexHealthIssue_Issue
+		switch issue.Issue {
+		case state.IndexHealthInvalid:
+			issueType = pganalyze_collector.IndexHealthIssue_INVALID
+		case state.IndexHealthDuplicate:
+			issueType = pganalyze_collector.IndexHealthIssue_DUPLICATE
+		case state.IndexHealthCoveredByPrimaryKey:
+			issueType = pganalyze_collector.IndexHealthIssue_COVERED_BY_PRIMARY_KEY
+		case state.IndexHealthPrefixDuplicate:
+			issueType = pganalyze_collector.IndexHealthIssue_PREFIX_DUPLICATE
+		case state.IndexHealthUnused:
+			issueType = pganalyze_collector.IndexHealthIssue_UNUSED
+		default:
+			issueType = pganalyze_collector.IndexHealthIssue_UNKNOWN
+		}
+
+		data.Issues = append(data.Issues, &pganalyze_collector.IndexHealthIssue{
+			RelationIdx: relationIdx,
+			IndexName:   issue.IndexName,
+			IndexDef:    issue.IndexDef,
+			Issue:       issueType,
+			SizeBytes:   issue.SizeBytes,
+			IdxScan:     issue.IdxScan,
+			CoveredBy:   issue.CoveredBy,
+		})
+	}
+
+	r.Data = &pganalyze_collector.Report_IndexHealthReportData{IndexHealthReportData: &data}
+
+	return &r
+}
+
+// indexColumnList - Returns the columns (including operator classes and sort
+// order) of an index definition as a list, or nil if the index is not a plain
+// btree index on columns (e.g. has expressions, a predicate or INCLUDE columns)
+func indexColumnList(index state.PostgresIndex) []string {
+	if index.IndexType != "btree" {
+		return nil
+	}
+	for _, column := range index.Columns {
+		if column == 0 {
+			return nil
+		}
+	}
+	start := strings.Index(index.IndexDef, " USING btree (")
+	if start == -1 || !strings.HasSuffix(index.IndexDef, ")") ||
+		strings.Contains(index.IndexDef, " WHERE ") || strings.Contains(index.IndexDef, " INCLUDE (") {
+		return nil
+	}
+	columns := index.IndexDef[start+len(" USING btree (") : len(index.IndexDef)-1]
+	return strings.Split(columns, ", ")
+}
+
+// indexDefinitionKey - Returns the part of the index definition that identifies
+// its structure, i.e. everything but the index name and uniqueness
+func indexDefinitionKey(index state.PostgresIndex) string {
+	start := strings.Index(index.IndexDef, " USING ")
+	if start == -1 {
+		return index.IndexDef
+	}
+	return index.IndexDef[start:]
+}
+
+// enforcesConstraint - Whether dropping the index would change behaviour beyond query performance
+func enforcesConstraint(index state.PostgresIndex) bool {
+	return index.IsPrimary || index.IsUnique || index.ConstraintDef.Valid
+}
+
+// preferredIndex - Returns true if a should be kept over b when both are redundant to each other
+func preferredIndex(a state.PostgresIndex, b state.PostgresIndex, indexStats state.PostgresIndexStatsMap) bool {
+	if a.IsPrimary != b.IsPrimary {
+		return a.IsPrimary
+	}
+	if a.ConstraintDef.Valid != b.ConstraintDef.Valid {
+		return a.ConstraintDef.Valid
+	}
+	if a.IsUnique != b.IsUnique {
+		return a.IsUnique
+	}
+	if indexStats[a.IndexOid].IdxScan != indexStats[b.IndexOid].IdxScan {
+		return indexStats[a.IndexOid].IdxScan > indexStats[b.IndexOid].IdxScan
+	}
+	return a.IndexOid < b.IndexOid
+}
+
+func findIndexHealthIssues(indices []state.PostgresIndex, indexStats state.PostgresIndexStatsMap, checkUnused bool) []state.PostgresIndexHealthIssue {
+	var issues []state.PostgresIndexHealthIssue
+
+	sorted := make([]state.PostgresIndex, len(indices))
+	copy(sorted, indices)
+	sort.Slice(sorted, func(i, j int) bool { return preferredIndex(sorted[i], sorted[j], indexStats) })
+
+	for i, index := range sorted {
+		issue := state.PostgresIndexHealthIssue{
+			IndexName: index.Name,
+			IndexDef:  index.IndexDef,
+			SizeBytes: indexStats[index.IndexOid].SizeBytes,
+			IdxScan:   indexStats[index.IndexOid].IdxScan,
+		}
+
+		if !index.IsValid {
+			issue.Issue = state.IndexHealthInvalid
+			issues = append(issues, issue)
+			continue
+		}
+
+		// Since indices are sorted by preference, only an earlier index can make this one an exact duplicate
+		for _, other := range sorted[:i] {
+			if other.IsValid && indexDefinitionKey(index) == indexDefinitionKey(other) {
+				issue.Issue = state.IndexHealthDuplicate
+				if other.IsPrimary {
+					issue.Issue = state.IndexHealthCoveredByPrimaryKey
+				}
+				issue.CoveredBy = other.Name
+				break
+			}
+		}
+
+		// Any index starting with the same columns makes a non-unique index redundant
+		columns := indexColumnList(index)
+		if issue.Issue == "" && !index.IsUnique && columns != nil {
+			for j, other := range sorted {
+				if i == j || !other.IsValid {
+					continue
+				}
+				otherColumns := indexColumnList(other)
+				if len(otherColumns) <= len(columns) || !isPrefix(columns, otherColumns) {
+					continue
+				}
+				issue.Issue = state.IndexHealthPrefixDuplicate
+				if other.IsPrimary {
+					issue.Issue = state.IndexHealthCoveredByPrimaryKey
+				}
+				issue.CoveredBy = other.Name
+				break
+			}
+		}
+
+		if issue.Issue == "" && checkUnused && issue.IdxScan == 0 && !enforcesConstraint(index) {
+			issue.Issue = state.IndexHealthUnused
+		}
+
+		if issue.Issue != "" {
+			issues = append(issues, issue)
+		}
+	}
+
+	return issues
+}
+
+func isPrefix(prefix []string, list []string) bool {
+	for i, value := range prefix {
+		if list[i] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// Table - Returns the indexes with issues, largest first
+func (report *IndexHealthReport) Table() ([]string, [][]string) {
+	issues := append([]state.PostgresIndexHealthIssue{}, report.Data.Issues...)
+	sort.SliceStable(issues, func(i, j int) bool {
+		return issues[i].SizeBytes > issues[j].SizeBytes
+	})
+
+	var rows [][]string
+	for _, issue := range issues {
+		rows = append(rows, []string{issue.DatabaseName, issue.SchemaName, issue.RelationName, issue.IndexName,
+			issue.Issue, formatBytes(issue.SizeBytes), formatInt(issue.IdxScan), issue.CoveredBy})
+	}
+	return []string{"DATABASE", "SCHEMA", "TABLE", "INDEX", "ISSUE", "SIZE", "SCANS", "COVERED BY"}, rows
+}
@@ -13,11 +13,17 @@ import (
 type Report interface {
 	RunID() string
 	ReportType() string
-	Run(server *state.Server, logger *util.Logger, connection *sql.DB) error
+	Run(server *state.Server, logger *util.Logger, connection *sql.DB, globalCollectionOpts state.CollectionOpts) error
 	Result() *pganalyze_collector.Report
 }
 
-var SupportedReports = []string{"bloat", "buffercache", "vacuum", "sequence"}
+// LocalReport - Implemented by reports whose data can't be represented in the
+// report protocol buffers format yet, to allow showing their results locally
+type LocalReport interface {
+	LocalResult() interface{}
+}
+
+var SupportedReports = []string{"bloat", "buffercache", "vacuum", "sequence", "index_health", "wraparound", "schema_changes", "ha_role_changes"}
 
 func InitializeReport(reportType string, reportRunID string) (Report, error) {
 	switch reportType {
@@ -29,6 +35,16 @@ func InitializeReport(reportType string, reportRunID string) (Report, error) {
 		return &VacuumReport{ReportRunID: reportRunID, CollectedAt: time.Now()}, nil
 	case "sequence":
 		return &SequenceReport{ReportRunID: reportRunID, CollectedAt: time.Now()}, nil
+	case "bloat_exact":
+		return &BloatExactReport{ReportRunID: reportRunID, CollectedAt: time.Now()}, nil
+	case "index_health":
+		return &IndexHealthReport{ReportRunID: reportRunID, CollectedAt: time.Now()}, nil
+	case "wraparound":
+		return &WraparoundReport{ReportRunID: reportRunID, CollectedAt: time.Now()}, nil
+	case "schema_changes":
+		return &SchemaChangesReport{ReportRunID: reportRunID, CollectedAt: time.Now()}, nil
+	case "ha_role_changes":
+		return &HARoleChangesReport{ReportRunID: reportRunID, CollectedAt: time.Now()}, nil
 	default:
 		return nil, fmt.Errorf("Unknown report type: %s", reportType)
 	}
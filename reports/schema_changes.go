@@ -0,0 +1,89 @@
+package reports
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/output/transform"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// SchemaChangesReport - Report on the schema changes detected between recent full snapshots
+type SchemaChangesReport struct {
+	ReportRunID string
+	CollectedAt time.Time
+	Data        []state.PostgresSchemaChange
+}
+
+// RunID - Returns the ID of this report run
+func (report SchemaChangesReport) RunID() string {
+	return report.ReportRunID
+}
+
+// ReportType - Returns the type of the report as a string
+func (report SchemaChangesReport) ReportType() string {
+	return "schema_changes"
+}
+
+// Run the report
+//
+// Schema changes are detected as part of the full snapshot, this returns the
+// rolling change log kept in the collector state, most recent change first.
+func (report *SchemaChangesReport) Run(server *state.Server, logger *util.Logger, connection *sql.DB, globalCollectionOpts state.CollectionOpts) (err error) {
+	server.StateMutex.Lock()
+	changeLog := server.PrevState.SchemaChanges
+	server.StateMutex.Unlock()
+
+	report.Data = make([]state.PostgresSchemaChange, 0, len(changeLog))
+	for i := len(changeLog) - 1; i >= 0; i-- {
+		report.Data = append(report.Data, changeLog[i])
+	}
+
+	return nil
+}
+
+// Result of the report
+func (report *SchemaChangesReport) Result() *pganalyze_collector.Report {
+	var r pganalyze_collector.Report
+	var data pganalyze_collector.SchemaChangesReportData
+
+	r.ReportRunId = report.ReportRunID
+	r.ReportType = report.ReportType()
+	r.CollectedAt, _ = ptypes.TimestampProto(report.CollectedAt)
+
+	databaseNameToIdx := make(map[string]int32)
+	for _, change := range report.Data {
+		databaseIdx, exists := databaseNameToIdx[change.DatabaseName]
+		if !exists {
+			databaseIdx = int32(len(data.DatabaseReferences))
+			databaseNameToIdx[change.DatabaseName] = databaseIdx
+			data.DatabaseReferences = append(data.DatabaseReferences, &pganalyze_collector.DatabaseReference{Name: change.DatabaseName})
+		}
+		data.SchemaChanges = append(data.SchemaChanges, transform.SchemaChangeToProto(change, databaseIdx))
+	}
+
+	r.Data = &pganalyze_collector.Report_SchemaChangesReportData{SchemaChangesReportData: &data}
+
+	return &r
+}
+
+// Table - Returns the schema changes, most recent first
+func (report *SchemaChangesReport) Table() ([]string, [][]string) {
+	var rows [][]string
+	for _, change := range report.Data {
+		name := change.SchemaName
+		if change.RelationName != "" {
+			name += "." + change.RelationName
+		}
+		if change.ObjectName != "" {
+			name += "." + change.ObjectName
+		}
+		rows = append(rows, []string{change.DetectedAt.Format("2006-01-02 15:04:05"), change.DatabaseName,
+			change.ChangeType, change.ObjectType, name, strings.Join(change.Details, "; ")})
+	}
+	return []string{"DETECTED AT", "DATABASE", "CHANGE", "OBJECT TYPE", "NAME", "DETAILS"}, rows
+}
@@ -2,6 +2,9 @@ package reports
 
 import (
 	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
@@ -29,7 +32,7 @@ func (report SequenceReport) ReportType() string {
 }
 
 // Run the report
-func (report *SequenceReport) Run(server *state.Server, logger *util.Logger, connection *sql.DB) (err error) {
+func (report *SequenceReport) Run(server *state.Server, logger *util.Logger, connection *sql.DB, globalCollectionOpts state.CollectionOpts) (err error) {
 	report.Data, err = postgres.GetSequenceReport(logger, connection)
 	if err != nil {
 		return
@@ -117,3 +120,53 @@ func (report *SequenceReport) Result() *pganalyze_collector.Report {
 
 	return &r
 }
+
+// Table - Returns the sequences closest to exhaustion first, taking into account the
+// maximum value of the columns they are used for
+func (report *SequenceReport) Table() ([]string, [][]string) {
+	type sequenceRow struct {
+		row       []string
+		usedRatio float64
+	}
+
+	columnsBySequence := make(map[state.Oid][]state.PostgresSerialColumn)
+	for _, column := range report.Data.SerialColumns {
+		columnsBySequence[column.SequenceOid] = append(columnsBySequence[column.SequenceOid], column)
+	}
+
+	var sequenceRows []sequenceRow
+	for oid, s := range report.Data.Sequences {
+		limit := s.MaxValue
+		var columnNames []string
+		for _, column := range columnsBySequence[oid] {
+			columnNames = append(columnNames, column.SchemaName+"."+column.RelationName+"."+column.ColumnName)
+			if column.MaximumValue < uint64(limit) {
+				limit = int64(column.MaximumValue)
+			}
+		}
+
+		var usedRatio float64
+		if s.IncrementBy < 0 {
+			if s.MaxValue != s.MinValue {
+				usedRatio = float64(s.MaxValue-s.LastValue) / float64(s.MaxValue-s.MinValue)
+			}
+		} else if limit != s.MinValue {
+			usedRatio = float64(s.LastValue-s.MinValue) / float64(limit-s.MinValue)
+		}
+
+		sequenceRows = append(sequenceRows, sequenceRow{
+			row: []string{s.SchemaName, s.SequenceName, strings.Join(columnNames, ", "),
+				formatInt(s.LastValue), formatInt(limit), fmt.Sprintf("%.2f%%", usedRatio*100), formatBool(s.IsCycled)},
+			usedRatio: usedRatio,
+		})
+	}
+	sort.SliceStable(sequenceRows, func(i, j int) bool {
+		return sequenceRows[i].usedRatio > sequenceRows[j].usedRatio
+	})
+
+	var rows [][]string
+	for _, r := range sequenceRows {
+		rows = append(rows, r.row)
+	}
+	return []string{"SCHEMA", "SEQUENCE", "COLUMNS", "LAST VALUE", "LIMIT", "USED %", "CYCLE"}, rows
+}
@@ -0,0 +1,60 @@
+package reports
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/guregu/null"
+)
+
+// TableReport - Implemented by reports that can be rendered as a human-readable table
+// for local output, with the most important rows first (e.g. most bloated tables)
+type TableReport interface {
+	Table() (columns []string, rows [][]string)
+}
+
+func formatBytes(bytes int64) string {
+	units := []string{"bytes", "kB", "MB", "GB", "TB"}
+	value := float64(bytes)
+	unit := 0
+	for value >= 1024 && unit < len(units)-1 {
+		value /= 1024
+		unit++
+	}
+	if unit == 0 {
+		return fmt.Sprintf("%d %s", bytes, units[unit])
+	}
+	return fmt.Sprintf("%.1f %s", value, units[unit])
+}
+
+func formatPercent(part float64, total float64) string {
+	if total == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%.1f%%", part/total*100)
+}
+
+func formatInt(value int64) string {
+	return strconv.FormatInt(value, 10)
+}
+
+func formatNullInt(value null.Int) string {
+	if !value.Valid {
+		return ""
+	}
+	return formatInt(value.Int64)
+}
+
+func formatNullTime(value null.Time) string {
+	if !value.Valid {
+		return ""
+	}
+	return value.Time.Format("2006-01-02 15:04:05")
+}
+
+func formatBool(value bool) string {
+	if value {
+		return "yes"
+	}
+	return "no"
+}
@@ -0,0 +1,119 @@
+package reports
+
+import (
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pganalyze/collector/state"
+)
+
+var formatBytesTests = []struct {
+	bytes    int64
+	expected string
+}{
+	{0, "0 bytes"},
+	{1023, "1023 bytes"},
+	{1024, "1.0 kB"},
+	{1536, "1.5 kB"},
+	{10 * 1024 * 1024, "10.0 MB"},
+	{3 * 1024 * 1024 * 1024, "3.0 GB"},
+	{2048 * 1024 * 1024 * 1024 * 1024, "2048.0 TB"},
+}
+
+func TestFormatBytes(t *testing.T) {
+	for _, test := range formatBytesTests {
+		actual := formatBytes(test.bytes)
+		if actual != test.expected {
+			t.Errorf("formatBytes(%d): expected %s, got %s", test.bytes, test.expected, actual)
+		}
+	}
+}
+
+func TestFormatPercent(t *testing.T) {
+	if actual := formatPercent(1, 3); actual != "33.3%" {
+		t.Errorf("Expected 33.3%%, got %s", actual)
+	}
+	if actual := formatPercent(1, 0); actual != "" {
+		t.Errorf("Expected empty string for zero total, got %s", actual)
+	}
+}
+
+func TestBloatTable(t *testing.T) {
+	stats := state.PostgresBloatStats{
+		Relations: []state.PostgresRelationBloat{
+			{SchemaName: "public", RelationName: "small", TotalBytes: 8192, BloatBytes: 0},
+			{SchemaName: "public", RelationName: "bloated", TotalBytes: 4 * 1024 * 1024, BloatBytes: 1024 * 1024},
+		},
+		Indices: []state.PostgresIndexBloat{
+			{SchemaName: "public", IndexName: "bloated_pkey", TotalBytes: 2 * 1024 * 1024, BloatBytes: 512 * 1024},
+		},
+	}
+
+	columns, rows := bloatTable(stats)
+	expectedColumns := []string{"TYPE", "SCHEMA", "NAME", "TOTAL SIZE", "BLOAT", "BLOAT %"}
+	expectedRows := [][]string{
+		{"table", "public", "bloated", "4.0 MB", "1.0 MB", "25.0%"},
+		{"index", "public", "bloated_pkey", "2.0 MB", "512.0 kB", "25.0%"},
+		{"table", "public", "small", "8.0 kB", "0 bytes", "0.0%"},
+	}
+	cfg := pretty.CompareConfig
+	if diff := cfg.Compare(expectedColumns, columns); diff != "" {
+		t.Errorf("Unexpected columns, diff: (-want +got)\n%s", diff)
+	}
+	if diff := cfg.Compare(expectedRows, rows); diff != "" {
+		t.Errorf("Unexpected rows, diff: (-want +got)\n%s", diff)
+	}
+}
+
+func TestSequenceTable(t *testing.T) {
+	report := SequenceReport{
+		Data: state.PostgresSequenceReport{
+			Sequences: state.PostgresSequenceInformationMap{
+				// bigint sequence that was just created
+				1: {SchemaName: "public", SequenceName: "fresh_id_seq", LastValue: 1, IncrementBy: 1, MinValue: 1, MaxValue: 9223372036854775807},
+				// bigint sequence used for an integer column, which limits it to the integer range
+				2: {SchemaName: "public", SequenceName: "users_id_seq", LastValue: 1073741824, IncrementBy: 1, MinValue: 1, MaxValue: 9223372036854775807},
+				// Descending sequence, which counts down towards its minimum value
+				3: {SchemaName: "public", SequenceName: "countdown_seq", LastValue: -91, IncrementBy: -1, MinValue: -101, MaxValue: -1, IsCycled: true},
+			},
+			SerialColumns: []state.PostgresSerialColumn{
+				{SchemaName: "public", RelationName: "users", ColumnName: "id", DataType: "integer", MaximumValue: 2147483647, SequenceOid: 2},
+			},
+		},
+	}
+
+	columns, rows := report.Table()
+	expectedColumns := []string{"SCHEMA", "SEQUENCE", "COLUMNS", "LAST VALUE", "LIMIT", "USED %", "CYCLE"}
+	expectedRows := [][]string{
+		{"public", "countdown_seq", "", "-91", "-1", "90.00%", "yes"},
+		{"public", "users_id_seq", "public.users.id", "1073741824", "2147483647", "50.00%", "no"},
+		{"public", "fresh_id_seq", "", "1", "9223372036854775807", "0.00%", "no"},
+	}
+	cfg := pretty.CompareConfig
+	if diff := cfg.Compare(expectedColumns, columns); diff != "" {
+		t.Errorf("Unexpected columns, diff: (-want +got)\n%s", diff)
+	}
+	if diff := cfg.Compare(expectedRows, rows); diff != "" {
+		t.Errorf("Unexpected rows, diff: (-want +got)\n%s", diff)
+	}
+}
+
+func TestVacuumTable(t *testing.T) {
+	report := VacuumReport{
+		Data: state.PostgresVacuumStats{
+			Relations: []state.PostgresVacuumStatsEntry{
+				{SchemaName: "public", RelationName: "clean", LiveRowCount: 100, DeadRowCount: 0, AutovacuumEnabled: true},
+				{SchemaName: "public", RelationName: "dirty", LiveRowCount: 75, DeadRowCount: 25, AutovacuumEnabled: false},
+			},
+		},
+	}
+
+	_, rows := report.Table()
+	expectedRows := [][]string{
+		{"public", "dirty", "75", "25", "25.0%", "", "", "no"},
+		{"public", "clean", "100", "0", "0.0%", "", "", "yes"},
+	}
+	if diff := pretty.Compare(expectedRows, rows); diff != "" {
+		t.Errorf("Unexpected rows, diff: (-want +got)\n%s", diff)
+	}
+}
@@ -2,6 +2,7 @@ package reports
 
 import (
 	"database/sql"
+	"sort"
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
@@ -29,7 +30,7 @@ func (report VacuumReport) ReportType() string {
 }
 
 // Run the report
-func (report *VacuumReport) Run(server *state.Server, logger *util.Logger, connection *sql.DB) (err error) {
+func (report *VacuumReport) Run(server *state.Server, logger *util.Logger, connection *sql.DB, globalCollectionOpts state.CollectionOpts) (err error) {
 	report.Data, err = postgres.GetVacuumStats(logger, connection, server.Config.IgnoreSchemaRegexp)
 	if err != nil {
 		return
@@ -99,3 +100,28 @@ func (report *VacuumReport) Result() *pganalyze_collector.Report {
 
 	return &r
 }
+
+// Table - Returns tables with the most dead rows first
+func (report *VacuumReport) Table() ([]string, [][]string) {
+	relations := append([]state.PostgresVacuumStatsEntry{}, report.Data.Relations...)
+	sort.SliceStable(relations, func(i, j int) bool {
+		return relations[i].DeadRowCount > relations[j].DeadRowCount
+	})
+
+	var rows [][]string
+	for _, relation := range relations {
+		lastVacuum := relation.LastAutoVacuumRun
+		if relation.LastManualVacuumRun.Valid && (!lastVacuum.Valid || relation.LastManualVacuumRun.Time.After(lastVacuum.Time)) {
+			lastVacuum = relation.LastManualVacuumRun
+		}
+		lastAnalyze := relation.LastAutoAnalyzeRun
+		if relation.LastManualAnalyzeRun.Valid && (!lastAnalyze.Valid || relation.LastManualAnalyzeRun.Time.After(lastAnalyze.Time)) {
+			lastAnalyze = relation.LastManualAnalyzeRun
+		}
+		rows = append(rows, []string{relation.SchemaName, relation.RelationName,
+			formatInt(int64(relation.LiveRowCount)), formatInt(int64(relation.DeadRowCount)),
+			formatPercent(float64(relation.DeadRowCount), float64(relation.LiveRowCount+relation.DeadRowCount)),
+			formatNullTime(lastVacuum), formatNullTime(lastAnalyze), formatBool(relation.AutovacuumEnabled)})
+	}
+	return []string{"SCHEMA", "TABLE", "LIVE ROWS", "DEAD ROWS", "DEAD %", "LAST VACUUM", "LAST ANALYZE", "AUTOVACUUM"}, rows
+}
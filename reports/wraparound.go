@@ -0,0 +1,279 @@
+package reports
+
+import (
+	"database/sql"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/input/postgres"
+	"github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// Number of tables with the oldest relfrozenxid to include for each database
+const wraparoundRelationsPerDatabase = 20
+
+// Number of running transactions holding back the xmin horizon to include
+const wraparoundTransactionsLimit = 10
+
+// WraparoundReport - Report on transaction ID and multixact ID wraparound risk
+type WraparoundReport struct {
+	ReportRunID string
+	CollectedAt time.Time
+	Data        state.PostgresWraparoundReport
+}
+
+// RunID - Returns the ID of this report run
+func (report WraparoundReport) RunID() string {
+	return report.ReportRunID
+}
+
+// ReportType - Returns the type of the report as a string
+func (report WraparoundReport) ReportType() string {
+	return "wraparound"
+}
+
+// Run the report
+func (report *WraparoundReport) Run(server *state.Server, logger *util.Logger, connection *sql.DB, globalCollectionOpts state.CollectionOpts) (err error) {
+	version, err := postgres.GetPostgresVersion(logger, connection)
+	if err != nil {
+		return
+	}
+
+	report.Data.AutovacuumFreezeMaxAge, report.Data.AutovacuumMultixactFreezeMaxAge, err = postgres.GetWraparoundSettings(connection)
+	if err != nil {
+		return
+	}
+
+	report.Data.Databases, err = postgres.GetWraparoundDatabases(connection, version)
+	if err != nil {
+		return
+	}
+	sort.Slice(report.Data.Databases, func(i, j int) bool {
+		return report.databaseRisk(report.Data.Databases[i]) > report.databaseRisk(report.Data.Databases[j])
+	})
+
+	report.Data.XminHorizonHolders, err = postgres.GetXminHorizonHolders(logger, connection, version, wraparoundTransactionsLimit)
+	if err != nil {
+		return
+	}
+	sort.SliceStable(report.Data.XminHorizonHolders, func(i, j int) bool {
+		return report.Data.XminHorizonHolders[i].XminAge > report.Data.XminHorizonHolders[j].XminAge
+	})
+
+	databases, err := postgres.GetDatabases(logger, connection, version)
+	if err != nil {
+		return
+	}
+
+	for _, dbName := range postgres.SchemaDatabaseNames(server, databases, server.Config.SystemType) {
+		db, err := postgres.EstablishConnection(server, logger, globalCollectionOpts, dbName)
+		if err != nil {
+			logger.PrintWarning("Skipping table wraparound data of database %s, due to connection error: %s", dbName, err)
+			continue
+		}
+
+		relations, err := postgres.GetWraparoundRelations(db, version, dbName, report.Data.AutovacuumFreezeMaxAge, server.Config.IgnoreSchemaRegexp, wraparoundRelationsPerDatabase)
+		db.Close()
+		if err != nil {
+			logger.PrintWarning("Skipping table wraparound data of database %s, due to error: %s", dbName, err)
+			continue
+		}
+		report.Data.Relations = append(report.Data.Relations, relations...)
+	}
+	sort.Slice(report.Data.Relations, func(i, j int) bool {
+		a := report.Data.Relations[i]
+		b := report.Data.Relations[j]
+		return float64(a.XidAge)/float64(a.AutovacuumFreezeMaxAge) > float64(b.XidAge)/float64(b.AutovacuumFreezeMaxAge)
+	})
+
+	sample, err := postgres.GetXidSample(connection, version)
+	if err != nil {
+		return
+	}
+
+	server.StateMutex.Lock()
+	prevSample := server.PrevState.XidSample
+	server.StateMutex.Unlock()
+
+	report.estimateTimeRemaining(prevSample, sample)
+
+	return nil
+}
+
+// databaseRisk - Returns how close the database is to a forced anti-wraparound
+// autovacuum, as the fraction of the relevant freeze_max_age setting
+func (report *WraparoundReport) databaseRisk(database state.PostgresWraparoundDatabase) float64 {
+	risk := float64(database.XidAge) / float64(report.Data.AutovacuumFreezeMaxAge)
+	if database.MxidAge.Valid && report.Data.AutovacuumMultixactFreezeMaxAge > 0 {
+		mxidRisk := float64(database.MxidAge.Int64) / float64(report.Data.AutovacuumMultixactFreezeMaxAge)
+		if mxidRisk > risk {
+			risk = mxidRisk
+		}
+	}
+	return risk
+}
+
+func (report *WraparoundReport) estimateTimeRemaining(prevSample state.PostgresXidSample, sample state.PostgresXidSample) {
+	if prevSample.NextXid == 0 || !sample.SampledAt.After(prevSample.SampledAt) {
+		return
+	}
+	elapsedSecs := sample.SampledAt.Sub(prevSample.SampledAt).Seconds()
+
+	var oldestXidAge int64
+	var oldestMxidAge null.Int
+	for _, database := range report.Data.Databases {
+		if database.XidAge > oldestXidAge {
+			oldestXidAge = database.XidAge
+		}
+		if database.MxidAge.Valid && database.MxidAge.Int64 > oldestMxidAge.Int64 {
+			oldestMxidAge = database.MxidAge
+		}
+	}
+
+	xidsPerSecond := float64(sample.NextXid-prevSample.NextXid) / elapsedSecs
+	report.Data.XidsPerSecond = null.FloatFrom(xidsPerSecond)
+	report.Data.SecondsUntilEmergencyAutovacuum = secondsUntil(oldestXidAge, report.Data.AutovacuumFreezeMaxAge, xidsPerSecond)
+	report.Data.SecondsUntilWraparound = secondsUntil(oldestXidAge, state.XidWraparoundLimit, xidsPerSecond)
+
+	if !prevSample.NextMultiXactID.Valid || !sample.NextMultiXactID.Valid || !oldestMxidAge.Valid {
+		return
+	}
+
+	// Multixact IDs are 32-bit without an epoch, account for them wrapping around since the last sample
+	mxidsConsumed := (sample.NextMultiXactID.Int64 - prevSample.NextMultiXactID.Int64 + (1 << 32)) % (1 << 32)
+	mxidsPerSecond := float64(mxidsConsumed) / elapsedSecs
+	report.Data.MxidsPerSecond = null.FloatFrom(mxidsPerSecond)
+	report.Data.MxidSecondsUntilEmergencyAutovacuum = secondsUntil(oldestMxidAge.Int64, report.Data.AutovacuumMultixactFreezeMaxAge, mxidsPerSecond)
+	report.Data.MxidSecondsUntilWraparound = secondsUntil(oldestMxidAge.Int64, state.XidWraparoundLimit, mxidsPerSecond)
+}
+
+// secondsUntil - Estimates the time until age reaches limit at the given rate, null if the age isn't increasing
+func secondsUntil(age int64, limit int64, perSecond float64) null.Float {
+	if perSecond <= 0 {
+		return null.Float{}
+	}
+	if age >= limit {
+		return null.FloatFrom(0)
+	}
+	return null.FloatFrom(float64(limit-age) / perSecond)
+}
+
+// Result of the report
+func (report *WraparoundReport) Result() *pganalyze_collector.Report {
+	var r pganalyze_collector.Report
+	var data pganalyze_collector.WraparoundReportData
+
+	r.ReportRunId = report.ReportRunID
+	r.ReportType = report.ReportType()
+	r.CollectedAt, _ = ptypes.TimestampProto(report.CollectedAt)
+
+	databaseNameToIdx := make(map[string]int32)
+	databaseIdx := func(name string) int32 {
+		idx, exists := databaseNameToIdx[name]
+		if !exists {
+			idx = int32(len(data.DatabaseReferences))
+			databaseNameToIdx[name] = idx
+			data.DatabaseReferences = append(data.DatabaseReferences, &pganalyze_collector.DatabaseReference{Name: name})
+		}
+		return idx
+	}
+
+	data.AutovacuumFreezeMaxAge = report.Data.AutovacuumFreezeMaxAge
+	data.AutovacuumMultixactFreezeMaxAge = report.Data.AutovacuumMultixactFreezeMaxAge
+
+	for _, database := range report.Data.Databases {
+		data.DatabaseInformations = append(data.DatabaseInformations, &pganalyze_collector.WraparoundDatabaseInformation{
+			DatabaseIdx: databaseIdx(database.DatabaseName),
+			XidAge:      database.XidAge,
+			HasMxidAge:  database.MxidAge.Valid,
+			MxidAge:     database.MxidAge.Int64,
+		})
+	}
+
+	for _, relation := range report.Data.Relations {
+		relationIdx := int32(len(data.RelationReferences))
+		data.RelationReferences = append(data.RelationReferences, &pganalyze_collector.RelationReference{
+			DatabaseIdx:  databaseIdx(relation.DatabaseName),
+			SchemaName:   relation.SchemaName,
+			RelationName: relation.RelationName,
+		})
+		data.RelationInformations = append(data.RelationInformations, &pganalyze_collector.WraparoundRelationInformation{
+			RelationIdx:            relationIdx,
+			IsToast:                relation.IsToast,
+			XidAge:                 relation.XidAge,
+			HasMxidAge:             relation.MxidAge.Valid,
+			MxidAge:                relation.MxidAge.Int64,
+			AutovacuumFreezeMaxAge: relation.AutovacuumFreezeMaxAge,
+		})
+	}
+
+	for _, holder := range report.Data.XminHorizonHolders {
+		var holderType pganalyze_collector.XminHorizonHolder_Type
+		switch holder.Type {
+		case state.XminHorizonTransaction:
+			holderType = pganalyze_collector.XminHorizonHolder_TRANSACTION
+		case state.XminHorizonPreparedTransaction:
+			holderType = pganalyze_collector.XminHorizonHolder_PREPARED_TRANSACTION
+		case state.XminHorizonReplicationSlot:
+			holderType = pganalyze_collector.XminHorizonHolder_REPLICATION_SLOT
+		case state.XminHorizonStandbyFeedback:
+			holderType = pganalyze_collector.XminHorizonHolder_STANDBY_FEEDBACK
+		default:
+			holderType = pganalyze_collector.XminHorizonHolder_UNKNOWN
+		}
+
+		info := pganalyze_collector.XminHorizonHolder{
+			Type:        holderType,
+			Name:        holder.Name,
+			DatabaseIdx: -1,
+			RoleName:    holder.RoleName.String,
+			StartedAt:   pganalyze_collector.NullTimeToNullTimestamp(holder.StartedAt),
+			XminAge:     holder.XminAge,
+		}
+		if holder.DatabaseName.Valid {
+			info.DatabaseIdx = databaseIdx(holder.DatabaseName.String)
+		}
+		data.XminHorizonHolders = append(data.XminHorizonHolders, &info)
+	}
+
+	data.HasXidsPerSecond = report.Data.XidsPerSecond.Valid
+	data.XidsPerSecond = report.Data.XidsPerSecond.Float64
+	data.HasSecondsUntilEmergencyAutovacuum = report.Data.SecondsUntilEmergencyAutovacuum.Valid
+	data.SecondsUntilEmergencyAutovacuum = report.Data.SecondsUntilEmergencyAutovacuum.Float64
+	data.HasSecondsUntilWraparound = report.Data.SecondsUntilWraparound.Valid
+	data.SecondsUntilWraparound = report.Data.SecondsUntilWraparound.Float64
+	data.HasMxidsPerSecond = report.Data.MxidsPerSecond.Valid
+	data.MxidsPerSecond = report.Data.MxidsPerSecond.Float64
+	data.HasMxidSecondsUntilEmergencyAutovacuum = report.Data.MxidSecondsUntilEmergencyAutovacuum.Valid
+	data.MxidSecondsUntilEmergencyAutovacuum = report.Data.MxidSecondsUntilEmergencyAutovacuum.Float64
+	data.HasMxidSecondsUntilWraparound = report.Data.MxidSecondsUntilWraparound.Valid
+	data.MxidSecondsUntilWraparound = report.Data.MxidSecondsUntilWraparound.Float64
+
+	r.Data = &pganalyze_collector.Report_WraparoundReportData{WraparoundReportData: &data}
+
+	return &r
+}
+
+// Table - Returns the databases and tables most at risk of wraparound first
+func (report *WraparoundReport) Table() ([]string, [][]string) {
+	var rows [][]string
+	for _, database := range report.Data.Databases {
+		rows = append(rows, []string{"database", database.DatabaseName, "",
+			formatInt(database.XidAge), formatPercent(float64(database.XidAge), float64(report.Data.AutovacuumFreezeMaxAge)),
+			formatNullInt(database.MxidAge)})
+	}
+	for _, relation := range report.Data.Relations {
+		name := relation.SchemaName + "." + relation.RelationName
+		if relation.IsToast {
+			name += " (TOAST)"
+		}
+		rows = append(rows, []string{"table", relation.DatabaseName, name,
+			formatInt(relation.XidAge), formatPercent(float64(relation.XidAge), float64(relation.AutovacuumFreezeMaxAge)),
+			formatNullInt(relation.MxidAge)})
+	}
+	return []string{"TYPE", "DATABASE", "NAME", "XID AGE", "% OF FREEZE MAX AGE", "MXID AGE"}, rows
+}
@@ -3,6 +3,7 @@ package runner
 import (
 	"database/sql"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -64,8 +65,21 @@ func processActivityForServer(server *state.Server, globalCollectionOpts state.C
 		return newState, false, errors.Wrap(err, "error collecting pg_stat_vacuum_progress")
 	}
 
+	activity.Progress, err = postgres.GetProgress(logger, connection, activity.Version, server.Config.IgnoreSchemaRegexp)
+	if err != nil {
+		// Progress reporting for non-vacuum operations is still evolving across
+		// Postgres versions, don't fail the whole activity snapshot because of it
+		logger.PrintWarning("Skipping progress reporting, due to error: %s", err)
+		err = nil
+	}
+
 	activity.CollectedAt = time.Now()
 
+	newState.ProgressPhases = trackProgressPhases(server.ActivityPrevState.ProgressPhases, activity.Progress, activity.CollectedAt)
+	for _, p := range activity.Progress {
+		logProgress(logger, p, activity.CollectedAt)
+	}
+
 	err = output.SubmitCompactActivitySnapshot(server, newGrant, globalCollectionOpts, logger, activity)
 	if err != nil {
 		return newState, false, errors.Wrap(err, "failed to upload/send activity snapshot")
@@ -75,6 +89,40 @@ func processActivityForServer(server *state.Server, globalCollectionOpts state.C
 	return newState, true, nil
 }
 
+// trackProgressPhases - Records when each operation was first seen in its current phase
+// (and how far along it was), and returns the phases to remember for the next run
+func trackProgressPhases(prevPhases map[uint64]state.ProgressPhase, progress []state.PostgresProgress, now time.Time) map[uint64]state.ProgressPhase {
+	phases := make(map[uint64]state.ProgressPhase)
+	for idx := range progress {
+		p := &progress[idx]
+		phase, exists := prevPhases[p.ProgressIdentity]
+		if !exists || phase.Phase != p.Phase {
+			phase = state.ProgressPhase{Phase: p.Phase, ObservedAt: now, Fraction: p.Fraction()}
+		}
+		p.PhaseObservedAt = phase.ObservedAt
+		p.PhaseObservedFraction = phase.Fraction
+		phases[p.ProgressIdentity] = phase
+	}
+	return phases
+}
+
+func logProgress(logger *util.Logger, p state.PostgresProgress, now time.Time) {
+	target := p.DatabaseName
+	if p.RelationName != "" {
+		target = fmt.Sprintf("%s.%s.%s", p.DatabaseName, p.SchemaName, p.RelationName)
+	}
+
+	details := p.Phase
+	if fraction := p.Fraction(); fraction.Valid {
+		details += fmt.Sprintf(", %.1f%% done", fraction.Float64*100)
+	}
+	if remaining, ok := p.EstimatedTimeRemaining(now); ok {
+		details += fmt.Sprintf(", ~%s remaining in this phase", remaining.Round(time.Second))
+	}
+
+	logger.PrintVerbose("%s in progress for %s (pid %d, running for %s): %s", p.CommandDetail, target, p.Pid, now.Sub(p.StartedAt).Round(time.Second), strings.TrimPrefix(details, ", "))
+}
+
 // CollectActivityFromAllServers - Collects activity from all servers and sends them to the pganalyze service
 func CollectActivityFromAllServers(servers []*state.Server, globalCollectionOpts state.CollectionOpts, logger *util.Logger) (allSuccessful bool) {
 	var wg sync.WaitGroup
@@ -89,27 +137,33 @@ func CollectActivityFromAllServers(servers []*state.Server, globalCollectionOpts
 		wg.Add(1)
 		go func(server *state.Server) {
 			prefixedLogger := logger.WithPrefixAndRememberErrors(server.Config.SectionName)
+			prefixedLogger.SnapshotType = "activity"
+
+			pool.acquire("activity", prefixedLogger)
 
 			if globalCollectionOpts.TestRun {
 				prefixedLogger.PrintInfo("Testing activity snapshots...")
 			}
 
+			runStartedAt := time.Now()
 			server.ActivityStateMutex.Lock()
 			newState, success, err := processActivityForServer(server, globalCollectionOpts, prefixedLogger)
 			if err != nil {
 				server.ActivityStateMutex.Unlock()
 				allSuccessful = false
 				prefixedLogger.PrintError("Could not collect activity for server: %s", err)
-				if server.Config.ErrorCallback != "" {
-					go runCompletionCallback("error", server.Config.ErrorCallback, server.Config.SectionName, "activity", err, prefixedLogger)
-				}
+				stateChanged := server.RecordRun("activity", err)
+				notifyCompletion(server, "activity", runStartedAt, err, nil, stateChanged, prefixedLogger)
 			} else {
 				server.ActivityPrevState = newState
 				server.ActivityStateMutex.Unlock()
-				if success && server.Config.SuccessCallback != "" {
-					go runCompletionCallback("success", server.Config.SuccessCallback, server.Config.SectionName, "activity", nil, prefixedLogger)
+				if success {
+					stateChanged := server.RecordRun("activity", nil)
+					prefixedLogger.WithDuration(time.Since(runStartedAt)).PrintVerbose("Completed activity snapshot in %s", time.Since(runStartedAt).Round(time.Millisecond))
+					notifyCompletion(server, "activity", runStartedAt, nil, nil, stateChanged, prefixedLogger)
 				}
 			}
+			pool.release("activity")
 			wg.Done()
 		}(servers[idx])
 	}
@@ -0,0 +1,49 @@
+package runner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pganalyze/collector/state"
+)
+
+func TestTrackProgressPhases(t *testing.T) {
+	startedAt := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	progress := func(phase string, blocksDone int64) []state.PostgresProgress {
+		return []state.PostgresProgress{{ProgressIdentity: 1, StartedAt: startedAt, Phase: phase, BlocksTotal: 100, BlocksDone: blocksDone}}
+	}
+
+	// The first time a phase is seen, there is no estimate yet
+	now := startedAt.Add(time.Minute)
+	p := progress("building index: scanning table", 10)
+	phases := trackProgressPhases(nil, p, now)
+	if _, ok := p[0].EstimatedTimeRemaining(now); ok {
+		t.Errorf("Expected no estimate on first observation of a phase")
+	}
+
+	// 10% progress in 10 seconds, 80% left
+	now = now.Add(10 * time.Second)
+	p = progress("building index: scanning table", 20)
+	phases = trackProgressPhases(phases, p, now)
+	remaining, ok := p[0].EstimatedTimeRemaining(now)
+	if !ok || remaining != 80*time.Second {
+		t.Errorf("Expected 80s remaining, got %s (valid: %v)", remaining, ok)
+	}
+
+	// A new phase starts over, instead of using the time since the operation started
+	now = now.Add(10 * time.Second)
+	p = progress("building index: loading tuples in tree", 5)
+	phases = trackProgressPhases(phases, p, now)
+	if _, ok := p[0].EstimatedTimeRemaining(now); ok {
+		t.Errorf("Expected no estimate after a phase change")
+	}
+	if phases[1].Phase != "building index: loading tuples in tree" || !phases[1].ObservedAt.Equal(now) {
+		t.Errorf("Expected new phase to be tracked from %s, got %+v", now, phases[1])
+	}
+
+	// Finished operations are no longer tracked
+	phases = trackProgressPhases(phases, nil, now)
+	if len(phases) != 0 {
+		t.Errorf("Expected no tracked phases, got %d", len(phases))
+	}
+}
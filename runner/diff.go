@@ -30,6 +30,11 @@ func diffState(logger *util.Logger, prevState state.PersistedState, newState sta
 	diffState.SystemDiskStats = diffSystemDiskStats(newState.System.DiskStats, prevState.System.DiskStats, collectedIntervalSecs)
 	diffState.CollectorStats = diffCollectorStats(newState.CollectorStats, prevState.CollectorStats)
 
+	// Without earlier settings (e.g. on the first run) every setting would show up as changed
+	if len(prevState.Settings) > 0 && len(newState.Settings) > 0 {
+		diffState.SettingChanges = state.DiffSettings(prevState.Settings, newState.Settings)
+	}
+
 	return
 }
 
@@ -0,0 +1,316 @@
+package runner
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// diffSchema - Determines the tables, columns, indexes, constraints and functions
+// that were added, dropped or altered since the previous full snapshot
+//
+// Only databases that have schema information in both snapshots are compared, so
+// a database we couldn't connect to doesn't show up as having all its objects dropped.
+func diffSchema(prevState state.PersistedState, newState state.PersistedState, databases []state.PostgresDatabase) (changes []state.PostgresSchemaChange) {
+	prevRelations := make(map[string]state.PostgresRelation)
+	prevDatabaseOids := make(map[state.Oid]bool)
+	for _, relation := range prevState.Relations {
+		prevRelations[relationKey(relation)] = relation
+		prevDatabaseOids[relation.DatabaseOid] = true
+	}
+	newRelations := make(map[string]bool)
+	databaseOids := make(map[state.Oid]bool)
+	for _, relation := range newState.Relations {
+		newRelations[relationKey(relation)] = true
+		if prevDatabaseOids[relation.DatabaseOid] {
+			databaseOids[relation.DatabaseOid] = true
+		}
+	}
+
+	var d schemaDiff
+	d.detectedAt = newState.CollectedAt
+
+	for _, relation := range newState.Relations {
+		if !databaseOids[relation.DatabaseOid] {
+			continue
+		}
+		prevRelation, exists := prevRelations[relationKey(relation)]
+		if !exists {
+			d.add(relation, state.SchemaObjectRelation, state.SchemaChangeAdded, "")
+			continue
+		}
+		d.diffRelation(prevRelation, relation)
+	}
+	for _, relation := range prevState.Relations {
+		if databaseOids[relation.DatabaseOid] && !newRelations[relationKey(relation)] {
+			d.add(relation, state.SchemaObjectRelation, state.SchemaChangeDropped, "")
+		}
+	}
+
+	// Function information is optional, avoid reporting all functions as added or dropped when it was turned on or off
+	if len(prevState.Functions) > 0 && len(newState.Functions) > 0 {
+		d.diffFunctions(prevState.Functions, newState.Functions, databaseOids)
+	}
+
+	databaseNames := make(map[state.Oid]string)
+	for _, database := range databases {
+		databaseNames[database.Oid] = database.Name
+	}
+	for i := range d.changes {
+		d.changes[i].DatabaseName = databaseNames[d.changes[i].DatabaseOid]
+	}
+
+	return d.changes
+}
+
+// appendSchemaChanges - Adds new changes to the rolling schema change log, dropping the oldest entries
+func appendSchemaChanges(changeLog []state.PostgresSchemaChange, changes []state.PostgresSchemaChange) []state.PostgresSchemaChange {
+	changeLog = append(append([]state.PostgresSchemaChange{}, changeLog...), changes...)
+	if len(changeLog) > state.SchemaChangeLogSize {
+		changeLog = changeLog[len(changeLog)-state.SchemaChangeLogSize:]
+	}
+	return changeLog
+}
+
+// logSchemaChanges - Outputs the schema changes detected in this run
+func logSchemaChanges(changes []state.PostgresSchemaChange, logger *util.Logger) {
+	for _, change := range changes {
+		name := change.SchemaName + "." + change.RelationName
+		if change.ObjectType == state.SchemaObjectFunction {
+			name = change.SchemaName + "." + change.ObjectName
+		} else if change.ObjectName != "" {
+			name = change.ObjectName + " on " + name
+		}
+		if len(change.Details) > 0 {
+			logger.PrintInfo("Schema change in database %s: %s %s %s (%s)", change.DatabaseName, change.ObjectType, name, change.ChangeType, strings.Join(change.Details, ", "))
+		} else {
+			logger.PrintInfo("Schema change in database %s: %s %s %s", change.DatabaseName, change.ObjectType, name, change.ChangeType)
+		}
+	}
+}
+
+func relationKey(relation state.PostgresRelation) string {
+	return fmt.Sprintf("%d/%s.%s", relation.DatabaseOid, relation.SchemaName, relation.RelationName)
+}
+
+type schemaDiff struct {
+	detectedAt time.Time
+	changes    []state.PostgresSchemaChange
+}
+
+func (d *schemaDiff) add(relation state.PostgresRelation, objectType string, changeType string, objectName string, details ...string) {
+	d.changes = append(d.changes, state.PostgresSchemaChange{
+		DetectedAt:   d.detectedAt,
+		DatabaseOid:  relation.DatabaseOid,
+		ObjectType:   objectType,
+		ChangeType:   changeType,
+		SchemaName:   relation.SchemaName,
+		RelationName: relation.RelationName,
+		ObjectName:   objectName,
+		Details:      details,
+	})
+}
+
+func (d *schemaDiff) diffRelation(prev state.PostgresRelation, new state.PostgresRelation) {
+	var details []string
+	if prev.RelationType != new.RelationType {
+		details = append(details, fmt.Sprintf("type changed from %s to %s", prev.RelationType, new.RelationType))
+	}
+	if prev.PersistenceType != new.PersistenceType {
+		details = append(details, fmt.Sprintf("persistence changed from %s to %s", prev.PersistenceType, new.PersistenceType))
+	}
+	if prev.ViewDefinition != new.ViewDefinition {
+		details = append(details, "view definition changed")
+	}
+	if !reflect.DeepEqual(prev.Options, new.Options) {
+		details = append(details, "storage parameters changed")
+	}
+	if len(details) > 0 {
+		d.add(new, state.SchemaObjectRelation, state.SchemaChangeAltered, "", details...)
+	}
+
+	// Columns, indexes and constraints are not collected while the table is locked
+	if prev.ExclusivelyLocked || new.ExclusivelyLocked {
+		return
+	}
+
+	d.diffColumns(prev, new)
+	d.diffIndices(prev, new)
+	d.diffConstraints(prev, new)
+}
+
+func (d *schemaDiff) diffColumns(prev state.PostgresRelation, new state.PostgresRelation) {
+	prevColumns := make(map[string]state.PostgresColumn)
+	for _, column := range prev.Columns {
+		prevColumns[column.Name] = column
+	}
+
+	for _, column := range new.Columns {
+		prevColumn, exists := prevColumns[column.Name]
+		delete(prevColumns, column.Name)
+		if !exists {
+			d.add(new, state.SchemaObjectColumn, state.SchemaChangeAdded, column.Name)
+			continue
+		}
+
+		var details []string
+		if prevColumn.DataType != column.DataType {
+			details = append(details, fmt.Sprintf("type changed from %s to %s", prevColumn.DataType, column.DataType))
+		}
+		if prevColumn.DefaultValue != column.DefaultValue {
+			details = append(details, fmt.Sprintf("default changed from %s to %s", nullableDefinition(prevColumn.DefaultValue), nullableDefinition(column.DefaultValue)))
+		}
+		if prevColumn.NotNull != column.NotNull {
+			if column.NotNull {
+				details = append(details, "NOT NULL added")
+			} else {
+				details = append(details, "NOT NULL removed")
+			}
+		}
+		if len(details) > 0 {
+			d.add(new, state.SchemaObjectColumn, state.SchemaChangeAltered, column.Name, details...)
+		}
+	}
+
+	for _, column := range prev.Columns {
+		if _, dropped := prevColumns[column.Name]; dropped {
+			d.add(new, state.SchemaObjectColumn, state.SchemaChangeDropped, column.Name)
+		}
+	}
+}
+
+func (d *schemaDiff) diffIndices(prev state.PostgresRelation, new state.PostgresRelation) {
+	prevIndices := make(map[string]state.PostgresIndex)
+	for _, index := range prev.Indices {
+		prevIndices[index.Name] = index
+	}
+
+	for _, index := range new.Indices {
+		prevIndex, exists := prevIndices[index.Name]
+		delete(prevIndices, index.Name)
+		if !exists {
+			d.add(new, state.SchemaObjectIndex, state.SchemaChangeAdded, index.Name, index.IndexDef)
+			continue
+		}
+
+		var details []string
+		if prevIndex.IndexDef != index.IndexDef {
+			details = append(details, fmt.Sprintf("definition changed from %s to %s", prevIndex.IndexDef, index.IndexDef))
+		}
+		if prevIndex.IsValid != index.IsValid {
+			if index.IsValid {
+				details = append(details, "became valid")
+			} else {
+				details = append(details, "became invalid")
+			}
+		}
+		if len(details) > 0 {
+			d.add(new, state.SchemaObjectIndex, state.SchemaChangeAltered, index.Name, details...)
+		}
+	}
+
+	for _, index := range prev.Indices {
+		if _, dropped := prevIndices[index.Name]; dropped {
+			d.add(new, state.SchemaObjectIndex, state.SchemaChangeDropped, index.Name, index.IndexDef)
+		}
+	}
+}
+
+func (d *schemaDiff) diffConstraints(prev state.PostgresRelation, new state.PostgresRelation) {
+	// Constraint names are not necessarily unique on a table, the type disambiguates most cases
+	constraintKey := func(constraint state.PostgresConstraint) string {
+		return constraint.Type + "/" + constraint.Name
+	}
+
+	prevConstraints := make(map[string]state.PostgresConstraint)
+	for _, constraint := range prev.Constraints {
+		prevConstraints[constraintKey(constraint)] = constraint
+	}
+
+	for _, constraint := range new.Constraints {
+		prevConstraint, exists := prevConstraints[constraintKey(constraint)]
+		delete(prevConstraints, constraintKey(constraint))
+		if !exists {
+			d.add(new, state.SchemaObjectConstraint, state.SchemaChangeAdded, constraint.Name, constraint.ConstraintDef)
+		} else if prevConstraint.ConstraintDef != constraint.ConstraintDef {
+			d.add(new, state.SchemaObjectConstraint, state.SchemaChangeAltered, constraint.Name,
+				fmt.Sprintf("definition changed from %s to %s", prevConstraint.ConstraintDef, constraint.ConstraintDef))
+		}
+	}
+
+	for _, constraint := range prev.Constraints {
+		if _, dropped := prevConstraints[constraintKey(constraint)]; dropped {
+			d.add(new, state.SchemaObjectConstraint, state.SchemaChangeDropped, constraint.Name, constraint.ConstraintDef)
+		}
+	}
+}
+
+func (d *schemaDiff) diffFunctions(prev []state.PostgresFunction, new []state.PostgresFunction, databaseOids map[state.Oid]bool) {
+	functionKey := func(function state.PostgresFunction) string {
+		return fmt.Sprintf("%d/%s.%s(%s)", function.DatabaseOid, function.SchemaName, function.FunctionName, function.Arguments)
+	}
+	addFunction := func(function state.PostgresFunction, changeType string, details ...string) {
+		d.changes = append(d.changes, state.PostgresSchemaChange{
+			DetectedAt:  d.detectedAt,
+			DatabaseOid: function.DatabaseOid,
+			ObjectType:  state.SchemaObjectFunction,
+			ChangeType:  changeType,
+			SchemaName:  function.SchemaName,
+			ObjectName:  fmt.Sprintf("%s(%s)", function.FunctionName, function.Arguments),
+			Details:     details,
+		})
+	}
+
+	prevFunctions := make(map[string]state.PostgresFunction)
+	for _, function := range prev {
+		if databaseOids[function.DatabaseOid] {
+			prevFunctions[functionKey(function)] = function
+		}
+	}
+
+	for _, function := range new {
+		if !databaseOids[function.DatabaseOid] {
+			continue
+		}
+		prevFunction, exists := prevFunctions[functionKey(function)]
+		delete(prevFunctions, functionKey(function))
+		if !exists {
+			addFunction(function, state.SchemaChangeAdded)
+			continue
+		}
+
+		var details []string
+		if prevFunction.Source != function.Source || prevFunction.SourceBin != function.SourceBin || prevFunction.Language != function.Language {
+			details = append(details, "body changed")
+		}
+		if prevFunction.Result != function.Result {
+			details = append(details, fmt.Sprintf("result changed from %s to %s", prevFunction.Result, function.Result))
+		}
+		if prevFunction.Volatile != function.Volatile || prevFunction.Strict != function.Strict ||
+			prevFunction.Leakproof != function.Leakproof || prevFunction.SecurityDefiner != function.SecurityDefiner ||
+			!reflect.DeepEqual(prevFunction.Config, function.Config) {
+			details = append(details, "attributes changed")
+		}
+		if len(details) > 0 {
+			addFunction(function, state.SchemaChangeAltered, details...)
+		}
+	}
+
+	for _, function := range prev {
+		if _, dropped := prevFunctions[functionKey(function)]; dropped {
+			addFunction(function, state.SchemaChangeDropped)
+		}
+	}
+}
+
+func nullableDefinition(value null.String) string {
+	if !value.Valid {
+		return "(none)"
+	}
+	return value.String
+}
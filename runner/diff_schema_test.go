@@ -0,0 +1,178 @@
+package runner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/guregu/null"
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pganalyze/collector/state"
+)
+
+var diffSchemaCollectedAt = time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+var diffSchemaDatabases = []state.PostgresDatabase{{Oid: 1, Name: "app"}, {Oid: 2, Name: "other"}}
+
+func diffSchemaRelation(name string, columns ...state.PostgresColumn) state.PostgresRelation {
+	return state.PostgresRelation{DatabaseOid: 1, Oid: 100, SchemaName: "public", RelationName: name, RelationType: "r", PersistenceType: "p", Columns: columns}
+}
+
+func diffSchemaChange(objectType string, changeType string, relationName string, objectName string, details ...string) state.PostgresSchemaChange {
+	return state.PostgresSchemaChange{
+		DetectedAt:   diffSchemaCollectedAt,
+		DatabaseOid:  1,
+		DatabaseName: "app",
+		ObjectType:   objectType,
+		ChangeType:   changeType,
+		SchemaName:   "public",
+		RelationName: relationName,
+		ObjectName:   objectName,
+		Details:      details,
+	}
+}
+
+var idColumn = state.PostgresColumn{Name: "id", DataType: "integer", NotNull: true}
+var emailColumn = state.PostgresColumn{Name: "email", DataType: "text"}
+
+var diffSchemaTests = []struct {
+	name     string
+	prev     state.PersistedState
+	new      state.PersistedState
+	expected []state.PostgresSchemaChange
+}{
+	{
+		"no changes",
+		state.PersistedState{Relations: []state.PostgresRelation{diffSchemaRelation("users", idColumn)}},
+		state.PersistedState{Relations: []state.PostgresRelation{diffSchemaRelation("users", idColumn)}},
+		nil,
+	},
+	{
+		"relation added and dropped",
+		state.PersistedState{Relations: []state.PostgresRelation{diffSchemaRelation("users"), diffSchemaRelation("accounts")}},
+		state.PersistedState{Relations: []state.PostgresRelation{diffSchemaRelation("users"), diffSchemaRelation("orders")}},
+		[]state.PostgresSchemaChange{
+			diffSchemaChange(state.SchemaObjectRelation, state.SchemaChangeAdded, "orders", ""),
+			diffSchemaChange(state.SchemaObjectRelation, state.SchemaChangeDropped, "accounts", ""),
+		},
+	},
+	{
+		"relation altered",
+		state.PersistedState{Relations: []state.PostgresRelation{diffSchemaRelation("users")}},
+		state.PersistedState{Relations: []state.PostgresRelation{func() state.PostgresRelation {
+			r := diffSchemaRelation("users")
+			r.PersistenceType = "u"
+			r.Options = map[string]string{"fillfactor": "80"}
+			return r
+		}()}},
+		[]state.PostgresSchemaChange{
+			diffSchemaChange(state.SchemaObjectRelation, state.SchemaChangeAltered, "users", "", "persistence changed from p to u", "storage parameters changed"),
+		},
+	},
+	{
+		"columns added, dropped and altered",
+		state.PersistedState{Relations: []state.PostgresRelation{diffSchemaRelation("users", idColumn, emailColumn)}},
+		state.PersistedState{Relations: []state.PostgresRelation{diffSchemaRelation("users",
+			state.PostgresColumn{Name: "id", DataType: "bigint", NotNull: true, DefaultValue: null.StringFrom("nextval('users_id_seq'::regclass)")},
+			state.PostgresColumn{Name: "name", DataType: "text", NotNull: true},
+		)}},
+		[]state.PostgresSchemaChange{
+			diffSchemaChange(state.SchemaObjectColumn, state.SchemaChangeAltered, "users", "id", "type changed from integer to bigint", "default changed from (none) to nextval('users_id_seq'::regclass)"),
+			diffSchemaChange(state.SchemaObjectColumn, state.SchemaChangeAdded, "users", "name"),
+			diffSchemaChange(state.SchemaObjectColumn, state.SchemaChangeDropped, "users", "email"),
+		},
+	},
+	{
+		"column changes are ignored while the table is locked",
+		state.PersistedState{Relations: []state.PostgresRelation{diffSchemaRelation("users", idColumn, emailColumn)}},
+		state.PersistedState{Relations: []state.PostgresRelation{func() state.PostgresRelation {
+			r := diffSchemaRelation("users")
+			r.ExclusivelyLocked = true
+			return r
+		}()}},
+		nil,
+	},
+	{
+		"indexes and constraints",
+		state.PersistedState{Relations: []state.PostgresRelation{func() state.PostgresRelation {
+			r := diffSchemaRelation("users", idColumn)
+			r.Indices = []state.PostgresIndex{
+				{Name: "users_pkey", IndexDef: "CREATE UNIQUE INDEX users_pkey ON public.users USING btree (id)", IsValid: true},
+				{Name: "users_email_idx", IndexDef: "CREATE INDEX users_email_idx ON public.users USING btree (email)", IsValid: true},
+			}
+			r.Constraints = []state.PostgresConstraint{{Name: "users_id_check", Type: "c", ConstraintDef: "CHECK ((id > 0))"}}
+			return r
+		}()}},
+		state.PersistedState{Relations: []state.PostgresRelation{func() state.PostgresRelation {
+			r := diffSchemaRelation("users", idColumn)
+			r.Indices = []state.PostgresIndex{
+				{Name: "users_pkey", IndexDef: "CREATE UNIQUE INDEX users_pkey ON public.users USING btree (id)", IsValid: true},
+				{Name: "users_email_idx", IndexDef: "CREATE INDEX users_email_idx ON public.users USING btree (email)", IsValid: false},
+				{Name: "users_lower_email_idx", IndexDef: "CREATE INDEX users_lower_email_idx ON public.users USING btree (lower(email))", IsValid: true},
+			}
+			r.Constraints = []state.PostgresConstraint{{Name: "users_id_check", Type: "c", ConstraintDef: "CHECK ((id >= 0))"}}
+			return r
+		}()}},
+		[]state.PostgresSchemaChange{
+			diffSchemaChange(state.SchemaObjectIndex, state.SchemaChangeAltered, "users", "users_email_idx", "became invalid"),
+			diffSchemaChange(state.SchemaObjectIndex, state.SchemaChangeAdded, "users", "users_lower_email_idx", "CREATE INDEX users_lower_email_idx ON public.users USING btree (lower(email))"),
+			diffSchemaChange(state.SchemaObjectConstraint, state.SchemaChangeAltered, "users", "users_id_check", "definition changed from CHECK ((id > 0)) to CHECK ((id >= 0))"),
+		},
+	},
+	{
+		"functions",
+		state.PersistedState{
+			Relations: []state.PostgresRelation{diffSchemaRelation("users")},
+			Functions: []state.PostgresFunction{
+				{DatabaseOid: 1, SchemaName: "public", FunctionName: "touch", Arguments: "", Language: "sql", Source: "SELECT 1", Volatile: "v"},
+				{DatabaseOid: 1, SchemaName: "public", FunctionName: "old", Arguments: "integer", Language: "sql", Source: "SELECT $1", Volatile: "v"},
+			},
+		},
+		state.PersistedState{
+			Relations: []state.PostgresRelation{diffSchemaRelation("users")},
+			Functions: []state.PostgresFunction{
+				{DatabaseOid: 1, SchemaName: "public", FunctionName: "touch", Arguments: "", Language: "sql", Source: "SELECT 2", Volatile: "s"},
+			},
+		},
+		[]state.PostgresSchemaChange{
+			{DetectedAt: diffSchemaCollectedAt, DatabaseOid: 1, DatabaseName: "app", ObjectType: state.SchemaObjectFunction, ChangeType: state.SchemaChangeAltered,
+				SchemaName: "public", ObjectName: "touch()", Details: []string{"body changed", "attributes changed"}},
+			{DetectedAt: diffSchemaCollectedAt, DatabaseOid: 1, DatabaseName: "app", ObjectType: state.SchemaObjectFunction, ChangeType: state.SchemaChangeDropped,
+				SchemaName: "public", ObjectName: "old(integer)"},
+		},
+	},
+	{
+		"databases missing from the previous snapshot are not compared",
+		state.PersistedState{Relations: []state.PostgresRelation{diffSchemaRelation("users")}},
+		state.PersistedState{Relations: []state.PostgresRelation{diffSchemaRelation("users"), {DatabaseOid: 2, Oid: 200, SchemaName: "public", RelationName: "events"}}},
+		nil,
+	},
+	{
+		"databases missing from the new snapshot are not reported as dropped",
+		state.PersistedState{Relations: []state.PostgresRelation{diffSchemaRelation("users")}},
+		state.PersistedState{},
+		nil,
+	},
+}
+
+func TestDiffSchema(t *testing.T) {
+	for _, test := range diffSchemaTests {
+		test.new.CollectedAt = diffSchemaCollectedAt
+		actual := diffSchema(test.prev, test.new, diffSchemaDatabases)
+		if diff := pretty.Compare(test.expected, actual); diff != "" {
+			t.Errorf("%s: unexpected changes, diff: (-want +got)\n%s", test.name, diff)
+		}
+	}
+}
+
+func TestAppendSchemaChanges(t *testing.T) {
+	var changeLog []state.PostgresSchemaChange
+	for i := 0; i < state.SchemaChangeLogSize+10; i++ {
+		changeLog = appendSchemaChanges(changeLog, []state.PostgresSchemaChange{{SchemaName: "public", RelationName: "t", ObjectName: string(rune('a' + i%26))}})
+	}
+	if len(changeLog) != state.SchemaChangeLogSize {
+		t.Fatalf("Expected change log to be limited to %d entries, got %d", state.SchemaChangeLogSize, len(changeLog))
+	}
+	if changeLog[0].ObjectName != string(rune('a'+10%26)) {
+		t.Errorf("Expected oldest entries to be dropped first, got %q as the oldest entry", changeLog[0].ObjectName)
+	}
+}
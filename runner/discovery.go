@@ -0,0 +1,125 @@
+package runner
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/input/system/kubernetes"
+	"github.com/pganalyze/collector/input/system/rds"
+	"github.com/pganalyze/collector/util"
+)
+
+// DiscoverServers - Runs all discovery sections (e.g. [discover_rds] or [discover_kubernetes]), and returns the
+// configuration of the servers they found
+//
+// Servers that are also configured in a regular section are skipped. If any discovery
+// failed, allSuccessful is false and the servers that section discovered previously are
+// kept, to avoid removing servers due to temporary errors (e.g. on reload).
+func DiscoverServers(conf config.Config, previous []config.ServerConfig, logger *util.Logger) (servers []config.ServerConfig, allSuccessful bool) {
+	allSuccessful = true
+
+	isConfigured := func(identifier config.ServerIdentifier) bool {
+		for _, s := range conf.Servers {
+			if s.Identifier == identifier {
+				return true
+			}
+		}
+		for _, s := range servers {
+			if s.Identifier == identifier {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, discovery := range conf.Discovery {
+		prefixedLogger := logger.WithPrefix(discovery.SectionName)
+		discovery.HTTPClient = config.CreateHTTPClient(discovery)
+
+		var instances []config.DiscoveredInstance
+		var err error
+		kind := "Amazon RDS instance(s)"
+		if config.IsKubernetesDiscoverySection(discovery.SectionName) {
+			kind = "Postgres instance(s) in Kubernetes"
+			instances, err = kubernetes.DiscoverInstances(discovery, prefixedLogger)
+		} else {
+			instances, err = rds.DiscoverInstances(discovery, prefixedLogger)
+		}
+		if err != nil {
+			prefixedLogger.PrintError("Could not discover %s: %s", kind, err)
+			allSuccessful = false
+			for _, server := range previous {
+				if strings.HasPrefix(server.SectionName, discovery.SectionName+"/") && !isConfigured(server.Identifier) {
+					servers = append(servers, server)
+				}
+			}
+			continue
+		}
+
+		for _, instance := range instances {
+			server, err := config.DiscoveredServerConfig(discovery, instance)
+			if err != nil {
+				prefixedLogger.PrintError("Skipping discovered instance: %s", err)
+				continue
+			}
+
+			if isConfigured(server.Identifier) {
+				prefixedLogger.PrintVerbose("Skipping discovered instance %s, since it is already configured", instance.ID)
+				continue
+			}
+
+			servers = append(servers, server)
+		}
+		prefixedLogger.PrintVerbose("Discovered %d %s", len(instances), kind)
+	}
+
+	return
+}
+
+// DiscoveredServersChanged - Returns whether any discovered servers were added, removed or
+// changed (e.g. a new host, or new credentials) compared to the previous discovery
+func DiscoveredServersChanged(previous []config.ServerConfig, current []config.ServerConfig, logger *util.Logger) bool {
+	previousByName := discoveredServersByName(previous)
+	currentByName := discoveredServersByName(current)
+
+	var added, removed, changed []string
+	for name, server := range currentByName {
+		previousServer, ok := previousByName[name]
+		if !ok {
+			added = append(added, name)
+		} else if !previousServer.Equal(server) {
+			changed = append(changed, name)
+		}
+	}
+	for name := range previousByName {
+		if _, ok := currentByName[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+	if len(added) == 0 && len(removed) == 0 && len(changed) == 0 {
+		return false
+	}
+
+	sort.Strings(added)
+	sort.Strings(removed)
+	sort.Strings(changed)
+	if len(added) > 0 {
+		logger.PrintInfo("Discovered new servers: %s", strings.Join(added, ", "))
+	}
+	if len(removed) > 0 {
+		logger.PrintInfo("Discovered servers no longer exist: %s", strings.Join(removed, ", "))
+	}
+	if len(changed) > 0 {
+		logger.PrintInfo("Discovered servers changed: %s", strings.Join(changed, ", "))
+	}
+	return true
+}
+
+func discoveredServersByName(servers []config.ServerConfig) map[string]config.ServerConfig {
+	byName := make(map[string]config.ServerConfig)
+	for _, server := range servers {
+		byName[server.SectionName] = server
+	}
+	return byName
+}
@@ -0,0 +1,89 @@
+package runner
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/pganalyze/collector/config"
+)
+
+var discoveredServer = config.ServerConfig{SectionName: "discover_rds/db1", DbHost: "db1.rds.amazonaws.com", DbUsername: "pganalyze", DbPassword: "secret"}
+
+var discoveredServersChangedTests = []struct {
+	description string
+	previous    []config.ServerConfig
+	current     []config.ServerConfig
+	expected    bool
+}{
+	{
+		"unchanged",
+		[]config.ServerConfig{discoveredServer},
+		[]config.ServerConfig{discoveredServer},
+		false,
+	},
+	{
+		"new HTTP client on every discovery run",
+		[]config.ServerConfig{discoveredServer},
+		[]config.ServerConfig{func() config.ServerConfig { s := discoveredServer; s.HTTPClient = &http.Client{}; return s }()},
+		false,
+	},
+	{
+		"added",
+		nil,
+		[]config.ServerConfig{discoveredServer},
+		true,
+	},
+	{
+		"removed",
+		[]config.ServerConfig{discoveredServer},
+		nil,
+		true,
+	},
+	{
+		"same name and host, but new credentials",
+		[]config.ServerConfig{discoveredServer},
+		[]config.ServerConfig{func() config.ServerConfig { s := discoveredServer; s.DbPassword = "rotated"; return s }()},
+		true,
+	},
+	{
+		"same name and host, but new port",
+		[]config.ServerConfig{discoveredServer},
+		[]config.ServerConfig{func() config.ServerConfig { s := discoveredServer; s.DbPort = 5433; return s }()},
+		true,
+	},
+}
+
+func TestDiscoveredServersChanged(t *testing.T) {
+	for _, test := range discoveredServersChangedTests {
+		actual := DiscoveredServersChanged(test.previous, test.current, testLogger)
+		if actual != test.expected {
+			t.Errorf("%s: expected %t, got %t", test.description, test.expected, actual)
+		}
+	}
+}
+
+func TestDiscoverServersKeepsPreviousOnFailure(t *testing.T) {
+	conf := config.Config{
+		Discovery: []config.ServerConfig{
+			{SectionName: "discover_kubernetes", DiscoverKubernetesAPIURL: "http://127.0.0.1:1", DiscoverKubernetesNamespace: "db"},
+		},
+		Servers: []config.ServerConfig{
+			{SectionName: "search", Identifier: config.ServerIdentifier{SystemID: "search.db.svc"}},
+		},
+	}
+	previous := []config.ServerConfig{
+		{SectionName: "discover_kubernetes/db/orders", Identifier: config.ServerIdentifier{SystemID: "orders.db.svc"}},
+		{SectionName: "discover_kubernetes/db/search", Identifier: config.ServerIdentifier{SystemID: "search.db.svc"}},
+		{SectionName: "discover_rds/db1", Identifier: config.ServerIdentifier{SystemID: "db1"}},
+	}
+
+	// Servers of a discovery section that was removed are not kept, and neither are servers
+	// that are now configured in a regular section
+	servers, allSuccessful := DiscoverServers(conf, previous, testLogger)
+	if allSuccessful {
+		t.Errorf("Expected discovery to fail")
+	}
+	if len(servers) != 1 || servers[0].SectionName != "discover_kubernetes/db/orders" {
+		t.Errorf("Expected previously discovered server to be kept, got %+v", servers)
+	}
+}
@@ -0,0 +1,43 @@
+package runner
+
+import (
+	"time"
+
+	"github.com/pganalyze/collector/grant"
+	"github.com/pganalyze/collector/input/postgres"
+	"github.com/pganalyze/collector/output"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// sendGenericExplain - Runs generic EXPLAINs for the top statements of the full snapshot,
+// and sends them as query samples in a log snapshot
+func sendGenericExplain(server *state.Server, globalCollectionOpts state.CollectionOpts, logger *util.Logger, ps state.PersistedState, ts state.TransientState) {
+	if server.Config.FilterQuerySample == "all" {
+		return
+	}
+
+	logsGrant, err := grant.GetLogsGrant(server, globalCollectionOpts, logger)
+	if err != nil {
+		logger.PrintWarning("Skipping generic EXPLAIN: could not get log grant: %s", err)
+		return
+	}
+	if !logsGrant.Valid {
+		logger.PrintVerbose("Skipping generic EXPLAIN: Feature not available on this pganalyze plan, or log data limit exceeded")
+		return
+	}
+
+	samples := postgres.RunGenericExplain(server, ps, ts, globalCollectionOpts, logger)
+	if len(samples) == 0 {
+		return
+	}
+	if globalCollectionOpts.TestRun {
+		logger.PrintInfo("  Ran generic EXPLAIN for %d statements", len(samples))
+	}
+
+	logState := state.TransientLogState{CollectedAt: time.Now(), QuerySamples: samples}
+	err = output.UploadAndSendLogs(server, logsGrant, globalCollectionOpts, logger, logState)
+	if err != nil {
+		logger.PrintWarning("Failed to send generic EXPLAIN results: %s", err)
+	}
+}
@@ -37,29 +37,45 @@ func collectDiffAndSubmit(server *state.Server, globalCollectionOpts state.Colle
 		logger.PrintInfo("  Test collection successful for %s", transientState.Version.Full)
 	}
 
+	prevState := haPrevState(server, connection, &newState, logger)
+
 	// This is the easiest way to avoid opening multiple connections to different databases on the same instance
 	connection.Close()
 
-	logsDisabled, logsDisabledReason := logs.ValidateLogCollectionConfig(server, transientState.Settings)
+	logsDisabled, logsDisabledReason := logs.ValidateLogCollectionConfig(server, newState.Settings)
 	collectionStatus := state.CollectionStatus{
 		LogSnapshotDisabled:       logsDisabled,
 		LogSnapshotDisabledReason: logsDisabledReason,
 	}
 
-	collectedIntervalSecs := uint32(newState.CollectedAt.Sub(server.PrevState.CollectedAt) / time.Second)
+	collectedIntervalSecs := uint32(newState.CollectedAt.Sub(prevState.CollectedAt) / time.Second)
 	if collectedIntervalSecs == 0 {
 		collectedIntervalSecs = 1 // Avoid divide by zero errors for fast consecutive runs
 	}
 
-	diffState := diffState(logger, server.PrevState, newState, collectedIntervalSecs)
+	diffState := diffState(logger, prevState, newState, collectedIntervalSecs)
+
+	logSettingChanges(diffState, transientState, globalCollectionOpts, logger)
 
-	transientState.HistoricStatementStats = server.PrevState.UnidentifiedStatementStats
+	diffState.SchemaChanges = diffSchema(prevState, newState, transientState.Databases)
+	logSchemaChanges(diffState.SchemaChanges, logger)
+	newState.SchemaChanges = appendSchemaChanges(prevState.SchemaChanges, diffState.SchemaChanges)
+	if server.Config.HAEnabled() {
+		diffState.HARoleChanges = server.TakeHARoleChanges()
+		newState.HARoleChanges = appendHARoleChanges(prevState.HARoleChanges, diffState.HARoleChanges)
+	}
+
+	transientState.HistoricStatementStats = prevState.UnidentifiedStatementStats
 
 	err = output.SendFull(server, globalCollectionOpts, logger, newState, diffState, transientState, collectedIntervalSecs)
 	if err != nil {
 		return newState, collectionStatus, err
 	}
 
+	if server.Config.EnableGenericExplain && globalCollectionOpts.CollectExplain {
+		sendGenericExplain(server, globalCollectionOpts, logger, newState, transientState)
+	}
+
 	// After we've done all processing, and in case we did a reset, make sure the
 	// next snapshot has an empty reference point
 	if transientState.ResetStatementStats != nil {
@@ -157,31 +173,41 @@ func CollectAllServers(servers []*state.Server, globalCollectionOpts state.Colle
 			var err error
 
 			prefixedLogger := logger.WithPrefixAndRememberErrors(server.Config.SectionName)
+			prefixedLogger.SnapshotType = "full"
+
+			pool.acquire("full", prefixedLogger)
 
 			if globalCollectionOpts.TestRun {
 				prefixedLogger.PrintInfo("Testing statistics collection...")
 			}
 
+			runStartedAt := time.Now()
 			server.StateMutex.Lock()
 			newState, grant, newCollectionStatus, err := processServer(server, globalCollectionOpts, prefixedLogger)
 			if err != nil {
 				server.StateMutex.Unlock()
 				allSuccessful = false
 				prefixedLogger.PrintError("Could not process server: %s", err)
+				stateChanged := server.RecordRun("full", err)
+				if grant.Valid {
+					server.RecordGrant(grant)
+				}
 				if grant.Valid && !globalCollectionOpts.TestRun && globalCollectionOpts.SubmitCollectedData {
 					server.Grant = grant
-					err = output.SendFailedFull(server, globalCollectionOpts, prefixedLogger)
-					if err != nil {
-						prefixedLogger.PrintWarning("Could not send error information to remote server: %s", err)
+					sendErr := output.SendFailedFull(server, globalCollectionOpts, prefixedLogger)
+					if sendErr != nil {
+						prefixedLogger.PrintWarning("Could not send error information to remote server: %s", sendErr)
 					}
 				}
-				if server.Config.ErrorCallback != "" {
-					go runCompletionCallback("error", server.Config.ErrorCallback, server.Config.SectionName, "full", err, prefixedLogger)
-				}
+				notifyCompletion(server, "full", runStartedAt, err, nil, stateChanged, prefixedLogger)
 			} else {
 				server.Grant = grant
 				server.PrevState = newState
+				rememberHAPrevState(server, newState)
 				server.StateMutex.Unlock()
+				stateChanged := server.RecordRun("full", nil)
+				prefixedLogger.WithDuration(time.Since(runStartedAt)).PrintVerbose("Completed full snapshot in %s", time.Since(runStartedAt).Round(time.Millisecond))
+				server.RecordGrant(grant)
 				server.CollectionStatusMutex.Lock()
 				if newCollectionStatus.LogSnapshotDisabled && !globalCollectionOpts.TestRun {
 					warning := fmt.Sprintf("Skipping logs: %s", newCollectionStatus.LogSnapshotDisabledReason)
@@ -189,10 +215,13 @@ func CollectAllServers(servers []*state.Server, globalCollectionOpts state.Colle
 				}
 				server.CollectionStatus = newCollectionStatus
 				server.CollectionStatusMutex.Unlock()
-				if server.Config.SuccessCallback != "" {
-					go runCompletionCallback("success", server.Config.SuccessCallback, server.Config.SectionName, "full", nil, prefixedLogger)
-				}
+				notifyCompletion(server, "full", runStartedAt, nil, map[string]int{
+					"relations":  len(newState.Relations),
+					"functions":  len(newState.Functions),
+					"statements": len(newState.StatementStats),
+				}, stateChanged, prefixedLogger)
 			}
+			pool.release("full")
 			wg.Done()
 		}(servers[idx])
 	}
@@ -0,0 +1,83 @@
+package runner
+
+import (
+	"database/sql"
+
+	"github.com/pganalyze/collector/input/postgres"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// haPrevState - Records which cluster member the new state was collected from, and returns
+// the state to diff against
+//
+// Statistics counters are kept separately by each member, so after a role change (e.g. a
+// failover) the first snapshot from the new member only establishes a new baseline.
+// Settings and schema information are shared by the cluster and kept. A different system
+// identifier means the state belongs to a different cluster: The state file is checked for
+// the state of that cluster (e.g. after the section was renamed), otherwise nothing is kept.
+func haPrevState(server *state.Server, connection *sql.DB, newState *state.PersistedState, logger *util.Logger) state.PersistedState {
+	prevState := server.PrevState
+	if !server.Config.HAEnabled() {
+		return prevState
+	}
+
+	target, _ := server.GetHATarget()
+	newState.HAMember = target.Address()
+	if connection != nil {
+		newState.SystemIdentifier = postgres.GetSystemIdentifier(connection)
+	}
+
+	if newState.SystemIdentifier != "" && prevState.SystemIdentifier != newState.SystemIdentifier {
+		if knownState, ok := server.HAPrevStateBySystemIdentifier[newState.SystemIdentifier]; ok {
+			logger.PrintVerbose("HA: Using previous state of database system %s from state file", newState.SystemIdentifier)
+			prevState = knownState
+		} else if prevState.SystemIdentifier != "" {
+			logger.PrintWarning("HA: Database system identifier changed from %s to %s, discarding previous statistics", prevState.SystemIdentifier, newState.SystemIdentifier)
+			return state.PersistedState{HARoleChanges: prevState.HARoleChanges}
+		}
+	}
+	if prevState.HAMember == "" || prevState.HAMember == newState.HAMember {
+		return prevState
+	}
+
+	logger.PrintVerbose("HA: Statistics were previously collected from %s, using the current values of %s as new baseline", prevState.HAMember, newState.HAMember)
+	return state.PersistedState{
+		CollectedAt:           prevState.CollectedAt,
+		Relations:             prevState.Relations,
+		Functions:             prevState.Functions,
+		Extensions:            prevState.Extensions,
+		EventTriggers:         prevState.EventTriggers,
+		Settings:              prevState.Settings,
+		SchemaChanges:         prevState.SchemaChanges,
+		StatementResetCounter: prevState.StatementResetCounter,
+		SystemIdentifier:      prevState.SystemIdentifier,
+		HAMember:              prevState.HAMember,
+		HARoleChanges:         prevState.HARoleChanges,
+
+		// Already diffed by the high frequency query stats runs, and still valid
+		UnidentifiedStatementStats: prevState.UnidentifiedStatementStats,
+	}
+}
+
+// rememberHAPrevState - Keeps the new state of the cluster by its system identifier, so that
+// switching back to a cluster after connecting to a different one diffs against its latest state
+func rememberHAPrevState(server *state.Server, newState state.PersistedState) {
+	if !server.Config.HAEnabled() || newState.SystemIdentifier == "" {
+		return
+	}
+	if server.HAPrevStateBySystemIdentifier == nil {
+		server.HAPrevStateBySystemIdentifier = make(map[string]state.PersistedState)
+	}
+	server.HAPrevStateBySystemIdentifier[newState.SystemIdentifier] = newState
+}
+
+// appendHARoleChanges - Adds the role changes detected since the last full snapshot to the
+// rolling change log, dropping the oldest entries
+func appendHARoleChanges(changeLog []state.HARoleChange, changes []state.HARoleChange) []state.HARoleChange {
+	changeLog = append(append([]state.HARoleChange{}, changeLog...), changes...)
+	if len(changeLog) > state.HARoleChangeLogSize {
+		changeLog = changeLog[len(changeLog)-state.HARoleChangeLogSize:]
+	}
+	return changeLog
+}
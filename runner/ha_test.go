@@ -0,0 +1,61 @@
+package runner
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/state"
+)
+
+func haTestServer(prevState state.PersistedState) *state.Server {
+	server := &state.Server{
+		Config:     config.ServerConfig{SectionName: "orders", DbHAMembers: "pg1:5432,pg2:5432"},
+		PrevState:  prevState,
+		StateMutex: &sync.Mutex{},
+		HAMutex:    &sync.Mutex{},
+	}
+	server.SetHAMembers([]state.HAMember{{Host: "pg1", Port: 5432, Role: state.HARolePrimary}}, state.HAMember{Host: "pg1", Port: 5432, Role: state.HARolePrimary}, state.HARolePrimary)
+	return server
+}
+
+func TestHAPrevState(t *testing.T) {
+	collectedAt := time.Now().Add(-10 * time.Minute)
+	clusterState := state.PersistedState{CollectedAt: collectedAt, SystemIdentifier: "7001", HAMember: "pg1:5432"}
+
+	// Same cluster and member: The previous state is used as is
+	server := haTestServer(clusterState)
+	newState := state.PersistedState{SystemIdentifier: "7001"}
+	prevState := haPrevState(server, nil, &newState, testLogger)
+	if prevState.SystemIdentifier != "7001" || !prevState.CollectedAt.Equal(collectedAt) || newState.HAMember != "pg1:5432" {
+		t.Errorf("Expected previous state to be kept, got %+v (new state %+v)", prevState, newState)
+	}
+
+	// Different cluster that is not in the state file: Nothing is kept
+	newState = state.PersistedState{SystemIdentifier: "7002"}
+	prevState = haPrevState(server, nil, &newState, testLogger)
+	if prevState.SystemIdentifier != "" || !prevState.CollectedAt.IsZero() {
+		t.Errorf("Expected previous state to be discarded, got %+v", prevState)
+	}
+
+	// The state of a cluster is found by its system identifier, e.g. after renaming the section
+	server = haTestServer(state.PersistedState{})
+	server.HAPrevStateBySystemIdentifier = map[string]state.PersistedState{"7001": clusterState}
+	newState = state.PersistedState{SystemIdentifier: "7001"}
+	prevState = haPrevState(server, nil, &newState, testLogger)
+	if prevState.SystemIdentifier != "7001" || !prevState.CollectedAt.Equal(collectedAt) {
+		t.Errorf("Expected previous state to be found by system identifier, got %+v", prevState)
+	}
+
+	// Switching back to a cluster uses its latest state, not the one from the state file
+	latestState := state.PersistedState{CollectedAt: collectedAt.Add(5 * time.Minute), SystemIdentifier: "7001", HAMember: "pg1:5432"}
+	rememberHAPrevState(server, latestState)
+	server.PrevState = state.PersistedState{CollectedAt: collectedAt.Add(8 * time.Minute), SystemIdentifier: "7002", HAMember: "pg1:5432"}
+	rememberHAPrevState(server, server.PrevState)
+	newState = state.PersistedState{SystemIdentifier: "7001"}
+	prevState = haPrevState(server, nil, &newState, testLogger)
+	if prevState.SystemIdentifier != "7001" || !prevState.CollectedAt.Equal(latestState.CollectedAt) {
+		t.Errorf("Expected latest state of the cluster, got %+v", prevState)
+	}
+}
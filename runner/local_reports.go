@@ -0,0 +1,150 @@
+package runner
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pganalyze/collector/reports"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// RunLocalReport - Runs globalCollectionOpts.LocalReport for all servers (or the one in
+// globalCollectionOpts.LocalReportSection), and outputs the result to stdout
+func RunLocalReport(servers []*state.Server, globalCollectionOpts state.CollectionOpts, logger *util.Logger) {
+	format := globalCollectionOpts.LocalReportFormat
+	if format != "table" && format != "json" && format != "csv" {
+		logger.PrintError("Error: Unsupported report format %s (supported: table, json, csv)", format)
+		return
+	}
+
+	found := false
+	for _, server := range servers {
+		if globalCollectionOpts.LocalReportSection != "" && server.Config.SectionName != globalCollectionOpts.LocalReportSection {
+			continue
+		}
+		found = true
+
+		report := runReport(globalCollectionOpts.LocalReport, server, globalCollectionOpts, logger)
+		if report == nil {
+			continue
+		}
+
+		if format == "table" && len(servers) > 1 {
+			fmt.Printf("[%s]\n", server.Config.SectionName)
+		}
+		err := renderReport(os.Stdout, report, format)
+		if err != nil {
+			logger.PrintError("Failed to output report: %s", err)
+			return
+		}
+		if format == "table" {
+			fmt.Printf("\n")
+		}
+	}
+
+	if !found {
+		logger.PrintError("Error: Config section %s not found", globalCollectionOpts.LocalReportSection)
+	}
+}
+
+// WriteLocalReports - Runs the reports configured in local_reports for each server,
+// and writes their results to local_reports_directory
+func WriteLocalReports(servers []*state.Server, globalCollectionOpts state.CollectionOpts, logger *util.Logger) {
+	for _, server := range servers {
+		if server.Config.LocalReports == "" {
+			continue
+		}
+
+		prefixedLogger := logger.WithPrefix(server.Config.SectionName)
+		format := server.Config.LocalReportsFormat
+		if server.Config.LocalReportsDirectory == "" {
+			prefixedLogger.PrintError("Error: local_reports requires local_reports_directory to be set, skipping local reports")
+			continue
+		}
+		if format != "table" && format != "json" && format != "csv" {
+			prefixedLogger.PrintError("Error: Unsupported local_reports_format %s (supported: csv, table, json), skipping local reports", format)
+			continue
+		}
+		extension := format
+		if format == "table" {
+			extension = "txt"
+		}
+
+		for _, reportType := range strings.Split(server.Config.LocalReports, ",") {
+			reportType = strings.TrimSpace(reportType)
+			report := runReport(reportType, server, globalCollectionOpts, prefixedLogger)
+			if report == nil {
+				continue
+			}
+
+			filename := fmt.Sprintf("%s_%s_%s.%s", server.Config.SectionName, reportType, time.Now().Format("20060102T150405"), extension)
+			filename = filepath.Join(server.Config.LocalReportsDirectory, strings.Replace(filename, string(filepath.Separator), "_", -1))
+			err := writeReportFile(filename, report, format)
+			if err != nil {
+				prefixedLogger.PrintError("Failed to write %s report to %s: %s", reportType, filename, err)
+				continue
+			}
+			prefixedLogger.PrintVerbose("Wrote %s report to %s", reportType, filename)
+		}
+	}
+}
+
+func writeReportFile(filename string, report reports.Report, format string) error {
+	// Write to a temporary file first, so readers never see a partially written report
+	tmpFilename := filename + ".tmp"
+	file, err := os.Create(tmpFilename)
+	if err != nil {
+		return err
+	}
+
+	err = renderReport(file, report, format)
+	file.Close()
+	if err != nil {
+		os.Remove(tmpFilename)
+		return err
+	}
+
+	return os.Rename(tmpFilename, filename)
+}
+
+// renderReport - Outputs the report in the given format (table, json or csv)
+//
+// Reports that can't be rendered as a table are always output as JSON.
+func renderReport(w io.Writer, report reports.Report, format string) error {
+	tableReport, ok := report.(reports.TableReport)
+	if format == "json" || !ok {
+		dataJSON, err := reportJSON(report)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "%s\n", dataJSON)
+		return err
+	}
+
+	columns, rows := tableReport.Table()
+	if format == "csv" {
+		csvWriter := csv.NewWriter(w)
+		csvWriter.Write(columns)
+		csvWriter.WriteAll(rows)
+		return csvWriter.Error()
+	}
+
+	if len(rows) == 0 {
+		_, err := fmt.Fprintf(w, "(no results)\n")
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "%s\n", strings.Join(columns, "\t"))
+	for _, row := range rows {
+		fmt.Fprintf(tw, "%s\n", strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
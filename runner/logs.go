@@ -2,6 +2,7 @@ package runner
 
 import (
 	"sync"
+	"time"
 
 	"github.com/pganalyze/collector/grant"
 	"github.com/pganalyze/collector/input"
@@ -40,6 +41,7 @@ func downloadLogsForServer(server *state.Server, globalCollectionOpts state.Coll
 	}
 
 	newLogState = persistedLogState
+	logs.TrackQueryPlans(server, transientLogState, logger)
 
 	err = output.UploadAndSendLogs(server, grant, globalCollectionOpts, logger, transientLogState)
 	if err != nil {
@@ -54,6 +56,7 @@ func downloadLogsForServer(server *state.Server, globalCollectionOpts state.Coll
 func downloadLogsFromOneServer(wg *sync.WaitGroup, server *state.Server, globalCollectionOpts state.CollectionOpts, logger *util.Logger) {
 	defer wg.Done()
 	prefixedLogger := logger.WithPrefixAndRememberErrors(server.Config.SectionName)
+	prefixedLogger.SnapshotType = "logs"
 
 	server.CollectionStatusMutex.Lock()
 	if server.CollectionStatus.LogSnapshotDisabled {
@@ -65,19 +68,21 @@ func downloadLogsFromOneServer(wg *sync.WaitGroup, server *state.Server, globalC
 	}
 	server.CollectionStatusMutex.Unlock()
 
+	runStartedAt := time.Now()
 	server.LogStateMutex.Lock()
 	newLogState, success, err := downloadLogsForServer(server, globalCollectionOpts, prefixedLogger)
 	if err != nil {
 		server.LogStateMutex.Unlock()
 		prefixedLogger.PrintError("Could not collect logs for server: %s", err)
-		if server.Config.ErrorCallback != "" {
-			go runCompletionCallback("error", server.Config.ErrorCallback, server.Config.SectionName, "logs", err, prefixedLogger)
-		}
+		stateChanged := server.RecordRun("logs", err)
+		notifyCompletion(server, "logs", runStartedAt, err, nil, stateChanged, prefixedLogger)
 	} else {
 		server.LogPrevState = newLogState
 		server.LogStateMutex.Unlock()
-		if success && server.Config.SuccessCallback != "" {
-			go runCompletionCallback("success", server.Config.SuccessCallback, server.Config.SectionName, "logs", nil, prefixedLogger)
+		if success {
+			stateChanged := server.RecordRun("logs", nil)
+			prefixedLogger.WithDuration(time.Since(runStartedAt)).PrintVerbose("Completed log snapshot in %s", time.Since(runStartedAt).Round(time.Millisecond))
+			notifyCompletion(server, "logs", runStartedAt, nil, nil, stateChanged, prefixedLogger)
 		}
 	}
 }
@@ -0,0 +1,143 @@
+package runner
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/pganalyze/collector/input/postgres"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// SetupMonitoringUser - Creates the monitoring role configured for each server, as well
+// as the helper functions in all monitored databases, using the given superuser credentials
+func SetupMonitoringUser(servers []*state.Server, globalCollectionOpts state.CollectionOpts, logger *util.Logger) {
+	for _, server := range servers {
+		prefixedLogger := logger.WithPrefix(server.Config.SectionName)
+		roleName, password := server.Config.GetConnectionCredentials()
+		if roleName == "" {
+			prefixedLogger.PrintError("Error: No db_username configured, skipping monitoring user setup")
+			continue
+		}
+
+		superuserServer := *server
+		superuserServer.Config = server.Config.WithCredentials(globalCollectionOpts.SuperuserUsername, globalCollectionOpts.SuperuserPassword)
+
+		err := setupMonitoringUserForServer(&superuserServer, roleName, password, globalCollectionOpts, prefixedLogger)
+		if err != nil {
+			prefixedLogger.PrintError("Error setting up monitoring user: %s", err)
+			continue
+		}
+		prefixedLogger.PrintInfo("Successfully set up monitoring user %s", roleName)
+	}
+
+	CheckPermissions(servers, globalCollectionOpts, logger)
+}
+
+func setupMonitoringUserForServer(server *state.Server, roleName string, password string, globalCollectionOpts state.CollectionOpts, logger *util.Logger) error {
+	connection, err := postgres.EstablishConnection(server, logger, globalCollectionOpts, "")
+	if err != nil {
+		return fmt.Errorf("Failed to connect to database: %s", err)
+	}
+	defer connection.Close()
+
+	version, err := postgres.GetPostgresVersion(logger, connection)
+	if err != nil {
+		return fmt.Errorf("Failed collecting Postgres Version: %s", err)
+	}
+
+	superuser, _ := postgres.MonitoringPrivileges(connection, server.Config.SystemType)
+	if !superuser {
+		return fmt.Errorf("User %s is not a superuser", server.Config.DbUsername)
+	}
+
+	err = postgres.SetupMonitoringRole(connection, logger, version, roleName, password)
+	if err != nil {
+		return err
+	}
+
+	databases, err := postgres.GetDatabases(logger, connection, version)
+	if err != nil {
+		return fmt.Errorf("Failed collecting pg_databases: %s", err)
+	}
+
+	primaryDbName := server.Config.GetDbName()
+	for _, dbName := range postgres.SchemaDatabaseNames(server, databases, server.Config.SystemType) {
+		dbConnection, err := postgres.EstablishConnection(server, logger, globalCollectionOpts, dbName)
+		if err != nil {
+			return fmt.Errorf("Failed to connect to database %s: %s", dbName, err)
+		}
+		err = postgres.SetupMonitoringHelpers(dbConnection, logger, server, version, roleName, dbName == primaryDbName)
+		dbConnection.Close()
+		if err != nil {
+			return fmt.Errorf("Database %s: %s", dbName, err)
+		}
+		logger.PrintVerbose("Installed helper functions in database %s", dbName)
+	}
+
+	return nil
+}
+
+// CheckPermissions - Reports which helper functions are missing in the monitored
+// databases, and which data collection is degraded as a result
+func CheckPermissions(servers []*state.Server, globalCollectionOpts state.CollectionOpts, logger *util.Logger) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "SERVER\tDATABASE\tHELPER\tSTATUS\tIMPACT\n")
+
+	for _, server := range servers {
+		prefixedLogger := logger.WithPrefix(server.Config.SectionName)
+
+		connection, err := postgres.EstablishConnection(server, prefixedLogger, globalCollectionOpts, "")
+		if err != nil {
+			prefixedLogger.PrintError("Error: Failed to connect to database: %s", err)
+			continue
+		}
+
+		version, err := postgres.GetPostgresVersion(prefixedLogger, connection)
+		if err != nil {
+			prefixedLogger.PrintError("Error collecting Postgres Version: %s", err)
+			connection.Close()
+			continue
+		}
+
+		superuser, monitoringRole := postgres.MonitoringPrivileges(connection, server.Config.SystemType)
+		databases, err := postgres.GetDatabases(prefixedLogger, connection, version)
+		connection.Close()
+		if err != nil {
+			prefixedLogger.PrintError("Error collecting pg_databases: %s", err)
+			continue
+		}
+
+		for _, dbName := range postgres.SchemaDatabaseNames(server, databases, server.Config.SystemType) {
+			dbConnection, err := postgres.EstablishConnection(server, prefixedLogger, globalCollectionOpts, dbName)
+			if err != nil {
+				prefixedLogger.PrintError("Error: Failed to connect to database %s: %s", dbName, err)
+				continue
+			}
+			statuses, err := postgres.CheckMonitoringHelpers(dbConnection, server, version)
+			dbConnection.Close()
+			if err != nil {
+				prefixedLogger.PrintError("Error checking helper functions in database %s: %s", dbName, err)
+				continue
+			}
+
+			for _, status := range statuses {
+				var statusText, impact string
+				if status.Installed {
+					statusText = "installed"
+				} else if superuser {
+					statusText = "not needed (superuser)"
+				} else if monitoringRole && status.Helper.CoveredByMonitoringRole {
+					statusText = "not needed (pg_monitor)"
+				} else {
+					statusText = "missing"
+					impact = status.Helper.MissingImpact
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", server.Config.SectionName, dbName, status.Helper.Name, statusText, impact)
+			}
+		}
+	}
+
+	w.Flush()
+}
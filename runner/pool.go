@@ -0,0 +1,211 @@
+package runner
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/util"
+)
+
+// Snapshot types that share the pool, by priority (lower runs first). Activity snapshots
+// are small and frequent, and should not have to wait for slow full snapshots.
+var snapshotPriority = map[string]int{
+	"activity":    0,
+	"query_stats": 1,
+	"full":        2,
+}
+
+// snapshotPool - Limits how many snapshots run at the same time across all servers, which
+// bounds the number of open connections and the memory used for snapshots being collected
+type snapshotPool struct {
+	mutex sync.Mutex
+
+	maxTotal     int
+	maxByType    map[string]int
+	memoryBudget uint64
+
+	running      map[string]int
+	runningTotal int
+	waiting      []*snapshotPoolWaiter
+
+	deferredFullSnapshots int
+
+	// Returns the memory currently used by the collector (replaced in tests)
+	memoryUsage func() uint64
+}
+
+type snapshotPoolWaiter struct {
+	snapshotType string
+	ready        chan struct{}
+	logger       *util.Logger
+	deferred     bool
+}
+
+type snapshotPoolStatusJSON struct {
+	Running               map[string]int `json:"running"`
+	Waiting               map[string]int `json:"waiting"`
+	DeferredFullSnapshots int            `json:"deferred_full_snapshots"`
+}
+
+var pool = newSnapshotPool()
+
+func newSnapshotPool() *snapshotPool {
+	return &snapshotPool{
+		maxByType:   make(map[string]int),
+		running:     make(map[string]int),
+		memoryUsage: heapMemoryUsage,
+	}
+}
+
+// ConfigureConcurrency - Applies the concurrency limits and memory budget from the
+// configuration, affecting snapshots that are started from now on
+func ConfigureConcurrency(conf config.Config, logger *util.Logger) {
+	pool.configure(conf.MaxConcurrency, map[string]int{
+		"full":        conf.MaxConcurrentFullSnapshots,
+		"activity":    conf.MaxConcurrentActivitySnapshots,
+		"query_stats": conf.MaxConcurrentQueryStatsSnapshots,
+	}, conf.MemoryBudgetMB)
+
+	if conf.MaxConcurrency > 0 {
+		pool.mutex.Lock()
+		logger.PrintVerbose("Running at most %d snapshots at the same time (at most %d full snapshots)", pool.maxTotal, pool.maxByType["full"])
+		pool.mutex.Unlock()
+	}
+}
+
+// configure - Sets the limits of the pool (0 = no limit)
+//
+// Unless set explicitly, full snapshots may only use 80% of the pool, so there is always
+// room left for activity and query statistics snapshots.
+func (p *snapshotPool) configure(maxTotal int, maxByType map[string]int, memoryBudgetMB int) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.maxTotal = maxTotal
+	p.maxByType = make(map[string]int)
+	for snapshotType, max := range maxByType {
+		p.maxByType[snapshotType] = max
+	}
+	if p.maxByType["full"] == 0 && maxTotal > 0 {
+		p.maxByType["full"] = maxTotal - maxTotal/5
+	}
+	p.memoryBudget = uint64(memoryBudgetMB) * 1024 * 1024
+
+	p.dispatch()
+}
+
+// acquire - Waits until a snapshot of the given type can be started
+func (p *snapshotPool) acquire(snapshotType string, logger *util.Logger) {
+	waiter := &snapshotPoolWaiter{snapshotType: snapshotType, ready: make(chan struct{}), logger: logger}
+
+	p.mutex.Lock()
+	idx := len(p.waiting)
+	for i, w := range p.waiting {
+		if snapshotPriority[w.snapshotType] > snapshotPriority[snapshotType] {
+			idx = i
+			break
+		}
+	}
+	p.waiting = append(p.waiting, nil)
+	copy(p.waiting[idx+1:], p.waiting[idx:])
+	p.waiting[idx] = waiter
+	p.dispatch()
+	p.mutex.Unlock()
+
+	<-waiter.ready
+}
+
+// release - Marks a snapshot as finished, and starts waiting snapshots in its place
+func (p *snapshotPool) release(snapshotType string) {
+	p.mutex.Lock()
+	p.running[snapshotType]--
+	p.runningTotal--
+	if snapshotType == "full" && p.memoryBudget > 0 && p.hasDeferredWaiters() {
+		// Make the memory of the finished snapshot available before checking the budget again
+		p.mutex.Unlock()
+		runtime.GC()
+		p.mutex.Lock()
+	}
+	p.dispatch()
+	p.mutex.Unlock()
+}
+
+// dispatch - Starts all waiting snapshots that fit within the limits, highest priority
+// first (must be called with the mutex held)
+func (p *snapshotPool) dispatch() {
+	var memoryUsage uint64
+	memoryChecked := false
+
+	remaining := p.waiting[:0]
+	for _, waiter := range p.waiting {
+		if p.maxTotal > 0 && p.runningTotal >= p.maxTotal {
+			remaining = append(remaining, waiter)
+			continue
+		}
+		if max := p.maxByType[waiter.snapshotType]; max > 0 && p.running[waiter.snapshotType] >= max {
+			remaining = append(remaining, waiter)
+			continue
+		}
+
+		// Defer full snapshots while over the memory budget, as long as other full snapshots
+		// are still running (and will free up memory once they are done)
+		if waiter.snapshotType == "full" && p.memoryBudget > 0 && p.running["full"] > 0 {
+			if !memoryChecked {
+				memoryUsage = p.memoryUsage()
+				memoryChecked = true
+			}
+			if memoryUsage > p.memoryBudget {
+				if !waiter.deferred {
+					waiter.deferred = true
+					p.deferredFullSnapshots++
+					waiter.logger.PrintWarning("Deferring full snapshot: memory usage (%d MB) exceeds memory_budget_mb (%d MB), waiting for %d running full snapshot(s) to finish", memoryUsage/1024/1024, p.memoryBudget/1024/1024, p.running["full"])
+				}
+				remaining = append(remaining, waiter)
+				continue
+			}
+		}
+
+		p.running[waiter.snapshotType]++
+		p.runningTotal++
+		close(waiter.ready)
+	}
+	for i := len(remaining); i < len(p.waiting); i++ {
+		p.waiting[i] = nil
+	}
+	p.waiting = remaining
+}
+
+func (p *snapshotPool) hasDeferredWaiters() bool {
+	for _, waiter := range p.waiting {
+		if waiter.deferred {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *snapshotPool) status() snapshotPoolStatusJSON {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	status := snapshotPoolStatusJSON{
+		Running:               make(map[string]int),
+		Waiting:               make(map[string]int),
+		DeferredFullSnapshots: p.deferredFullSnapshots,
+	}
+	for snapshotType := range snapshotPriority {
+		status.Running[snapshotType] = p.running[snapshotType]
+		status.Waiting[snapshotType] = 0
+	}
+	for _, waiter := range p.waiting {
+		status.Waiting[waiter.snapshotType]++
+	}
+	return status
+}
+
+func heapMemoryUsage() uint64 {
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+	return memStats.HeapAlloc
+}
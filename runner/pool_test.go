@@ -0,0 +1,98 @@
+package runner
+
+import (
+	"io/ioutil"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/pganalyze/collector/util"
+)
+
+var testLogger = &util.Logger{Destination: log.New(ioutil.Discard, "", 0)}
+
+func waitForPoolWaiting(t *testing.T, p *snapshotPool, snapshotType string, count int) {
+	for i := 0; i < 100; i++ {
+		if p.status().Waiting[snapshotType] == count {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("Expected %d waiting %s snapshot(s), got %d", count, snapshotType, p.status().Waiting[snapshotType])
+}
+
+func TestSnapshotPoolPriority(t *testing.T) {
+	p := newSnapshotPool()
+	p.configure(1, map[string]int{}, 0)
+
+	p.acquire("full", testLogger)
+
+	started := make(chan string, 2)
+	go func() {
+		p.acquire("full", testLogger)
+		started <- "full"
+	}()
+	waitForPoolWaiting(t, p, "full", 1)
+	go func() {
+		p.acquire("activity", testLogger)
+		started <- "activity"
+	}()
+	waitForPoolWaiting(t, p, "activity", 1)
+
+	// The activity snapshot was queued last, but runs first
+	p.release("full")
+	if first := <-started; first != "activity" {
+		t.Errorf("Expected activity snapshot to start first, got %s", first)
+	}
+	p.release("activity")
+	if second := <-started; second != "full" {
+		t.Errorf("Expected full snapshot to start second, got %s", second)
+	}
+	p.release("full")
+}
+
+func TestSnapshotPoolFullSnapshotLimit(t *testing.T) {
+	p := newSnapshotPool()
+	p.configure(5, map[string]int{}, 0)
+
+	// Full snapshots can use 4 of the 5 slots by default, leaving one for activity snapshots
+	for i := 0; i < 4; i++ {
+		p.acquire("full", testLogger)
+	}
+	go p.acquire("full", testLogger)
+	waitForPoolWaiting(t, p, "full", 1)
+	p.acquire("activity", testLogger)
+
+	if running := p.status().Running["full"]; running != 4 {
+		t.Errorf("Expected 4 running full snapshots, got %d", running)
+	}
+	p.release("full")
+	waitForPoolWaiting(t, p, "full", 0)
+}
+
+func TestSnapshotPoolMemoryBudget(t *testing.T) {
+	p := newSnapshotPool()
+	p.memoryUsage = func() uint64 { return 200 * 1024 * 1024 }
+	p.configure(0, map[string]int{}, 100)
+
+	// The first full snapshot always runs, even when over the budget
+	p.acquire("full", testLogger)
+
+	started := make(chan struct{})
+	go func() {
+		p.acquire("full", testLogger)
+		close(started)
+	}()
+	waitForPoolWaiting(t, p, "full", 1)
+	if deferred := p.status().DeferredFullSnapshots; deferred != 1 {
+		t.Errorf("Expected 1 deferred full snapshot, got %d", deferred)
+	}
+
+	// Activity snapshots are not affected by the memory budget
+	p.acquire("activity", testLogger)
+	p.release("activity")
+
+	p.release("full")
+	<-started
+	p.release("full")
+}
@@ -47,6 +47,15 @@ func gatherQueryStatsForServer(server *state.Server, globalCollectionOpts state.
 		return newState, nil
 	}
 
+	// Statistics counters are kept separately by each cluster member, don't diff them
+	// after a role change until the next full snapshot has established a new baseline
+	if server.Config.HAEnabled() {
+		target, _ := server.GetHATarget()
+		if server.PrevState.HAMember != "" && server.PrevState.HAMember != target.Address() {
+			return newState, nil
+		}
+	}
+
 	diffedStatementStats := diffStatements(newState.StatementStats, server.PrevState.StatementStats)
 	collectedIntervalSecs := uint32(newState.LastStatementStatsAt.Sub(server.PrevState.LastStatementStatsAt) / time.Second)
 
@@ -71,24 +80,27 @@ func GatherQueryStatsFromAllServers(servers []*state.Server, globalCollectionOpt
 		wg.Add(1)
 		go func(server *state.Server) {
 			prefixedLogger := logger.WithPrefixAndRememberErrors(server.Config.SectionName)
+			prefixedLogger.SnapshotType = "query_stats"
+
+			pool.acquire("query_stats", prefixedLogger)
 
+			runStartedAt := time.Now()
 			server.StateMutex.Lock()
 			newState, err := gatherQueryStatsForServer(server, globalCollectionOpts, prefixedLogger)
 
 			if err != nil {
 				server.StateMutex.Unlock()
 				prefixedLogger.PrintError("Could not collect query stats for server: %s", err)
-				if server.Config.ErrorCallback != "" {
-					go runCompletionCallback("error", server.Config.ErrorCallback, server.Config.SectionName, "query_stats", err, prefixedLogger)
-				}
+				stateChanged := server.RecordRun("query_stats", err)
+				notifyCompletion(server, "query_stats", runStartedAt, err, nil, stateChanged, prefixedLogger)
 			} else {
 				server.PrevState = newState
 				server.StateMutex.Unlock()
-				prefixedLogger.PrintVerbose("Successfully collected high frequency query statistics")
-				if server.Config.SuccessCallback != "" {
-					go runCompletionCallback("success", server.Config.SuccessCallback, server.Config.SectionName, "query_stats", nil, prefixedLogger)
-				}
+				prefixedLogger.WithDuration(time.Since(runStartedAt)).PrintVerbose("Successfully collected high frequency query statistics")
+				stateChanged := server.RecordRun("query_stats", nil)
+				notifyCompletion(server, "query_stats", runStartedAt, nil, map[string]int{"statements": len(newState.StatementStats)}, stateChanged, prefixedLogger)
 			}
+			pool.release("query_stats")
 			wg.Done()
 		}(servers[idx])
 	}
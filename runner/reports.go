@@ -38,7 +38,7 @@ func runReport(reportType string, server *state.Server, globalCollectionOpts sta
 		return nil
 	}
 
-	err = report.Run(server, logger, connection)
+	err = report.Run(server, logger, connection, globalCollectionOpts)
 	if err != nil {
 		logger.PrintError("Failed to run report: %s", err)
 		connection.Close()
@@ -62,7 +62,12 @@ type reportsApiResponse struct {
 }
 
 func getRequestedReports(server *state.Server, globalCollectionOpts state.CollectionOpts, logger *util.Logger) (requestedReports []reports.Report, grant state.Grant, err error) {
-	data := url.Values{"supported_reports": {strings.Join(reports.SupportedReports, ",")}}
+	supportedReports := append([]string{}, reports.SupportedReports...)
+	if server.Config.EnableBloatExact {
+		supportedReports = append(supportedReports, "bloat_exact")
+	}
+
+	data := url.Values{"supported_reports": {strings.Join(supportedReports, ",")}}
 	req, err := http.NewRequest("POST", server.Config.APIBaseURL+"/v2/reports/fetch_runs", strings.NewReader(data.Encode()))
 	if err != nil {
 		return
@@ -124,16 +129,33 @@ func RunTestReport(servers []*state.Server, globalCollectionOpts state.Collectio
 			continue
 		}
 
-		var out bytes.Buffer
-		var marshaler jsonpb.Marshaler
-		dataJSON, err := marshaler.MarshalToString(report.Result())
+		dataJSON, err := reportJSON(report)
 		if err != nil {
-			logger.PrintError("Failed to transform protocol buffers to JSON: %s", err)
+			logger.PrintError("Failed to transform report result to JSON: %s", err)
 			return
 		}
-		json.Indent(&out, []byte(dataJSON), "", "\t")
-		fmt.Printf("%s\n", out.String())
+		fmt.Printf("%s\n", dataJSON)
+	}
+}
+
+// reportJSON - Returns the indented JSON representation of the report result
+func reportJSON(report reports.Report) (string, error) {
+	if localReport, ok := report.(reports.LocalReport); ok {
+		dataJSON, err := json.MarshalIndent(localReport.LocalResult(), "", "\t")
+		if err != nil {
+			return "", err
+		}
+		return string(dataJSON), nil
+	}
+
+	var out bytes.Buffer
+	var marshaler jsonpb.Marshaler
+	dataJSON, err := marshaler.MarshalToString(report.Result())
+	if err != nil {
+		return "", err
 	}
+	json.Indent(&out, []byte(dataJSON), "", "\t")
+	return out.String(), nil
 }
 
 // RunRequestedReports - Retrieves current report requests from the server, runs them and submits their data
@@ -144,32 +166,42 @@ func RunRequestedReports(servers []*state.Server, globalCollectionOpts state.Col
 		}
 
 		prefixedLogger := logger.WithPrefix(server.Config.SectionName)
+		prefixedLogger.SnapshotType = "reports"
 
 		reports, grant, err := getRequestedReports(server, globalCollectionOpts, prefixedLogger)
 		if err != nil {
 			prefixedLogger.PrintError("Failed to get requested reports: %s", err)
+			server.RecordRun("reports", err)
 			continue
 		}
 
 		if len(reports) == 0 {
+			server.RecordRun("reports", nil)
 			continue
 		}
 
 		connection, err := postgres.EstablishConnection(server, prefixedLogger, globalCollectionOpts, "")
 		if err != nil {
 			prefixedLogger.PrintError("Error: Failed to connect to database: %s", err)
+			server.RecordRun("reports", err)
 			continue
 		}
 
+		var runErr error
 		for _, report := range reports {
-			err = report.Run(server, prefixedLogger, connection)
+			err = report.Run(server, prefixedLogger, connection, globalCollectionOpts)
 			if err != nil {
 				prefixedLogger.PrintError("Failed to run report: %s", err)
+				runErr = err
 				continue
 			}
 
-			output.SubmitReport(server, grant, report, prefixedLogger)
+			err = output.SubmitReport(server, grant, report, prefixedLogger)
+			if err != nil {
+				runErr = err
+			}
 		}
+		server.RecordRun("reports", runErr)
 
 		// This is the easiest way to avoid opening multiple connections to different databases on the same instance
 		connection.Close()
@@ -0,0 +1,115 @@
+package runner
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/input/postgres"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// logSettingChanges - Reports configuration changes since the last full snapshot,
+// as well as settings that are waiting for a restart or couldn't be applied
+func logSettingChanges(diffState state.DiffState, transientState state.TransientState, globalCollectionOpts state.CollectionOpts, logger *util.Logger) {
+	for _, change := range diffState.SettingChanges {
+		if change.PreviousValue == change.CurrentValue {
+			if change.PendingRestart {
+				logger.PrintInfo("Setting %s was changed%s and requires a restart to take effect", change.Name, settingSource(change.SourceFile, change.SourceLine))
+			}
+			continue
+		}
+		logger.PrintInfo("Setting %s changed: %s -> %s%s", change.Name,
+			settingValue(change.PreviousValue, change.Unit), settingValue(change.CurrentValue, change.Unit),
+			settingSource(change.SourceFile, change.SourceLine))
+	}
+
+	printFinding := logger.PrintVerbose
+	if globalCollectionOpts.TestRun {
+		printFinding = logger.PrintWarning
+	}
+
+	for _, fileError := range transientState.SettingFileErrors {
+		printFinding("Configuration file %s line %d could not be applied: %s", fileError.SourceFile, fileError.SourceLine, fileError.Error)
+	}
+}
+
+func settingValue(value null.String, unit null.String) string {
+	if !value.Valid {
+		return "(not set)"
+	}
+	if unit.Valid {
+		return value.String + " " + unit.String
+	}
+	return value.String
+}
+
+func settingSource(sourceFile null.String, sourceLine null.String) string {
+	if !sourceFile.Valid {
+		return ""
+	}
+	if sourceLine.Valid {
+		return fmt.Sprintf(" (%s:%s)", sourceFile.String, sourceLine.String)
+	}
+	return fmt.Sprintf(" (%s)", sourceFile.String)
+}
+
+// CompareSettings - Prints the configuration settings that differ between two servers
+func CompareSettings(servers []*state.Server, globalCollectionOpts state.CollectionOpts, logger *util.Logger) {
+	sectionNames := strings.Split(globalCollectionOpts.CompareSettings, ",")
+	if len(sectionNames) != 2 {
+		logger.PrintError("Error: --compare-settings requires two config section names separated by a comma")
+		return
+	}
+
+	var settings [2][]state.PostgresSetting
+	for i, sectionName := range sectionNames {
+		var server *state.Server
+		for _, s := range servers {
+			if s.Config.SectionName == strings.TrimSpace(sectionName) {
+				server = s
+			}
+		}
+		if server == nil {
+			logger.PrintError("Error: Config section %s not found", sectionName)
+			return
+		}
+
+		prefixedLogger := logger.WithPrefix(server.Config.SectionName)
+		connection, err := postgres.EstablishConnection(server, prefixedLogger, globalCollectionOpts, "")
+		if err != nil {
+			prefixedLogger.PrintError("Error: Failed to connect to database: %s", err)
+			return
+		}
+
+		version, err := postgres.GetPostgresVersion(prefixedLogger, connection)
+		if err == nil {
+			settings[i], err = postgres.GetSettings(connection, version)
+		}
+		connection.Close()
+		if err != nil {
+			prefixedLogger.PrintError("Error collecting config settings: %s", err)
+			return
+		}
+	}
+
+	changes := state.DiffSettings(settings[0], settings[1])
+	if len(changes) == 0 {
+		logger.PrintInfo("No differences in configuration settings")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "SETTING\t%s\t%s\n", strings.TrimSpace(sectionNames[0]), strings.TrimSpace(sectionNames[1]))
+	for _, change := range changes {
+		if change.PreviousValue == change.CurrentValue {
+			// Only the pending restart status differs
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", change.Name, settingValue(change.PreviousValue, change.Unit), settingValue(change.CurrentValue, change.Unit))
+	}
+	w.Flush()
+}
@@ -0,0 +1,165 @@
+package runner
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// A server is not considered ready if it had no successful full snapshot for this long
+// (full snapshots run every 10 minutes)
+const readyMaxFullSnapshotAge = 30 * time.Minute
+
+type runStatusJSON struct {
+	LastAttemptAt *time.Time `json:"last_attempt_at"`
+	LastSuccessAt *time.Time `json:"last_success_at"`
+	LastError     string     `json:"last_error,omitempty"`
+}
+
+type serverStatusJSON struct {
+	Section    string                   `json:"section"`
+	Ready      bool                     `json:"ready"`
+	NotReady   string                   `json:"not_ready_reason,omitempty"`
+	GrantValid bool                     `json:"grant_valid"`
+	Runs       map[string]runStatusJSON `json:"runs"`
+
+	LogTailActive             bool   `json:"log_tail_active"`
+	LogTailError              string `json:"log_tail_error,omitempty"`
+	LogSnapshotDisabledReason string `json:"log_snapshot_disabled_reason,omitempty"`
+}
+
+type statusJSON struct {
+	CollectorVersion string             `json:"collector_version"`
+	StartedAt        time.Time          `json:"started_at"`
+	Ready            bool               `json:"ready"`
+	Servers          []serverStatusJSON `json:"servers"`
+
+	SnapshotPool snapshotPoolStatusJSON `json:"snapshot_pool"`
+}
+
+// SetupStatusServer - Starts the local HTTP server with health check and status endpoints,
+// which is stopped again when the context is cancelled (e.g. on reload)
+//
+// The servers are retrieved on every request, since discovered servers can change at runtime.
+func SetupStatusServer(ctx context.Context, wg *sync.WaitGroup, listenAddress string, getServers func() []*state.Server, globalCollectionOpts state.CollectionOpts, logger *util.Logger) {
+	listener, err := net.Listen("tcp", listenAddress)
+	if err != nil {
+		logger.PrintError("Could not start status server: %s", err)
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "ok\n")
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		status := getStatus(getServers(), globalCollectionOpts)
+		if !status.Ready {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			for _, s := range status.Servers {
+				if !s.Ready {
+					fmt.Fprintf(w, "%s: %s\n", s.Section, s.NotReady)
+				}
+			}
+			return
+		}
+		fmt.Fprintf(w, "ok\n")
+	})
+	mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+		status := getStatus(getServers(), globalCollectionOpts)
+		w.Header().Set("Content-Type", "application/json")
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		encoder.Encode(status)
+	})
+
+	httpServer := &http.Server{Handler: mux}
+
+	logger.PrintVerbose("Status server listening on %s", listener.Addr())
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		httpServer.Shutdown(shutdownCtx)
+	}()
+
+	go func() {
+		err := httpServer.Serve(listener)
+		if err != nil && err != http.ErrServerClosed {
+			logger.PrintError("Status server failed: %s", err)
+		}
+	}()
+}
+
+func getStatus(servers []*state.Server, globalCollectionOpts state.CollectionOpts) statusJSON {
+	status := statusJSON{
+		CollectorVersion: util.CollectorVersion,
+		StartedAt:        globalCollectionOpts.StartedAt,
+		Ready:            true,
+		SnapshotPool:     pool.status(),
+	}
+
+	now := time.Now()
+	for _, server := range servers {
+		health := server.GetHealth()
+
+		server.CollectionStatusMutex.Lock()
+		logSnapshotDisabledReason := server.CollectionStatus.LogSnapshotDisabledReason
+		server.CollectionStatusMutex.Unlock()
+
+		s := serverStatusJSON{
+			Section:                   server.Config.SectionName,
+			Ready:                     true,
+			GrantValid:                health.GrantValid,
+			Runs:                      make(map[string]runStatusJSON),
+			LogTailActive:             health.LogTailActive,
+			LogTailError:              health.LogTailError,
+			LogSnapshotDisabledReason: logSnapshotDisabledReason,
+		}
+		for runType, run := range health.Runs {
+			s.Runs[runType] = runStatusJSON{
+				LastAttemptAt: timeOrNil(run.LastAttemptAt),
+				LastSuccessAt: timeOrNil(run.LastSuccessAt),
+				LastError:     run.LastError,
+			}
+		}
+
+		full := health.Runs["full"]
+		if full.LastSuccessAt.IsZero() {
+			if full.LastError != "" {
+				s.NotReady = "full snapshot failed: " + full.LastError
+			} else {
+				s.NotReady = "waiting for first full snapshot"
+			}
+		} else if full.LastError != "" {
+			s.NotReady = "last full snapshot failed: " + full.LastError
+		} else if now.Sub(full.LastSuccessAt) > readyMaxFullSnapshotAge {
+			s.NotReady = fmt.Sprintf("no successful full snapshot since %s", full.LastSuccessAt.Format(time.RFC3339))
+		}
+		if s.NotReady != "" {
+			s.Ready = false
+			status.Ready = false
+		}
+
+		status.Servers = append(status.Servers, s)
+	}
+
+	return status
+}
+
+func timeOrNil(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,143 @@
+package runner
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// Delay before the first retry of a failed webhook, doubled for every following retry
+var webhookRetryDelay = 1 * time.Second
+
+type webhookPayload struct {
+	Event            string         `json:"event"`
+	StateChange      string         `json:"state_change,omitempty"`
+	Section          string         `json:"section"`
+	SystemID         string         `json:"system_id"`
+	SystemType       string         `json:"system_type"`
+	SnapshotType     string         `json:"snapshot_type"`
+	StartedAt        time.Time      `json:"started_at"`
+	DurationMs       int64          `json:"duration_ms"`
+	ErrorMessage     string         `json:"error_message,omitempty"`
+	CollectorVersion string         `json:"collector_version"`
+	Stats            map[string]int `json:"stats,omitempty"`
+}
+
+// notifyCompletion - Runs the error/success callback and sends the webhook (if configured)
+// for a finished collection run
+//
+// stateChanged indicates whether the run type started failing with this run, or succeeded
+// again after failing before (as returned by Server.RecordRun).
+func notifyCompletion(server *state.Server, snapshotType string, runStartedAt time.Time, err error, stats map[string]int, stateChanged bool, logger *util.Logger) {
+	event := "success"
+	callbackCmd := server.Config.SuccessCallback
+	if err != nil {
+		event = "error"
+		callbackCmd = server.Config.ErrorCallback
+	}
+
+	if callbackCmd != "" {
+		go runCompletionCallback(event, callbackCmd, server.Config.SectionName, snapshotType, err, logger)
+	}
+
+	if server.Config.WebhookURL == "" || (server.Config.WebhookStateChangesOnly && !stateChanged) {
+		return
+	}
+	payload := webhookPayload{
+		Event:            event,
+		Section:          server.Config.SectionName,
+		SystemID:         server.Config.SystemID,
+		SystemType:       server.Config.SystemType,
+		SnapshotType:     snapshotType,
+		StartedAt:        runStartedAt,
+		DurationMs:       int64(time.Since(runStartedAt) / time.Millisecond),
+		CollectorVersion: util.CollectorVersion,
+		Stats:            stats,
+	}
+	if err != nil {
+		payload.ErrorMessage = err.Error()
+	}
+	if stateChanged && err != nil {
+		payload.StateChange = "failing"
+	} else if stateChanged {
+		payload.StateChange = "recovered"
+	}
+	go sendWebhook(server.Config, payload, logger)
+}
+
+// sendWebhook - POSTs the payload as JSON to the configured webhook URL, retrying on network
+// errors and server errors
+func sendWebhook(conf config.ServerConfig, payload webhookPayload, logger *util.Logger) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		logger.PrintError("Could not encode %s webhook (%s snapshot): %s", payload.Event, payload.SnapshotType, err)
+		return
+	}
+	headers, err := conf.GetWebhookHeaders()
+	if err != nil {
+		logger.PrintError("Could not send %s webhook (%s snapshot): %s", payload.Event, payload.SnapshotType, err)
+		return
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	delay := webhookRetryDelay
+	for attempt := 0; ; attempt++ {
+		var retry bool
+		retry, err = postWebhook(client, conf, headers, body)
+		if err == nil {
+			return
+		}
+		if !retry || attempt >= conf.WebhookRetries {
+			break
+		}
+		logger.PrintVerbose("Webhook (%s snapshot) failed, retrying in %s: %s", payload.SnapshotType, delay, err)
+		time.Sleep(delay)
+		delay *= 2
+	}
+	logger.PrintError("Could not send %s webhook (%s snapshot): %s", payload.Event, payload.SnapshotType, err)
+}
+
+// postWebhook - Sends the webhook once, and returns whether a failed request should be retried
+func postWebhook(client *http.Client, conf config.ServerConfig, headers map[string]string, body []byte) (retry bool, err error) {
+	req, err := http.NewRequest("POST", conf.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", fmt.Sprintf("pganalyze-collector/%s", util.CollectorVersion))
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	if conf.WebhookSecret != "" {
+		req.Header.Set("X-Pganalyze-Signature", "sha256="+webhookSignature(conf.WebhookSecret, body))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	retry = resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+	return retry, fmt.Errorf("unexpected status %s", resp.Status)
+}
+
+// webhookSignature - HMAC-SHA256 of the request body, which lets the receiver verify that the
+// webhook was sent by a collector that knows the shared secret
+func webhookSignature(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,124 @@
+package runner
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/state"
+)
+
+type webhookRequest struct {
+	headers http.Header
+	body    []byte
+}
+
+func newWebhookServer(statusCodes ...int) (*httptest.Server, chan webhookRequest) {
+	requests := make(chan webhookRequest, 10)
+	var mutex sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests <- webhookRequest{headers: r.Header, body: body}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		if len(statusCodes) > 0 {
+			w.WriteHeader(statusCodes[0])
+			statusCodes = statusCodes[1:]
+		}
+	}))
+	return server, requests
+}
+
+func receiveWebhook(t *testing.T, requests chan webhookRequest) webhookRequest {
+	select {
+	case request := <-requests:
+		return request
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected webhook request, got none")
+	}
+	return webhookRequest{}
+}
+
+func TestSendWebhook(t *testing.T) {
+	webhookRetryDelay = time.Millisecond
+	server, requests := newWebhookServer(http.StatusBadGateway, http.StatusOK)
+	defer server.Close()
+
+	conf := config.ServerConfig{WebhookURL: server.URL, WebhookHeaders: "Authorization: Bearer abc", WebhookSecret: "secret", WebhookRetries: 3}
+	payload := webhookPayload{Event: "error", Section: "server1", SnapshotType: "full", ErrorMessage: "failed to connect to database", Stats: map[string]int{"relations": 3}}
+	sendWebhook(conf, payload, testLogger)
+
+	// The first attempt fails with a server error, and is retried
+	receiveWebhook(t, requests)
+	request := receiveWebhook(t, requests)
+
+	if auth := request.headers.Get("Authorization"); auth != "Bearer abc" {
+		t.Errorf("Expected Authorization header \"Bearer abc\", got %q", auth)
+	}
+	expectedSignature := "sha256=" + webhookSignature("secret", request.body)
+	if signature := request.headers.Get("X-Pganalyze-Signature"); signature != expectedSignature {
+		t.Errorf("Expected signature %q, got %q", expectedSignature, signature)
+	}
+
+	var actual webhookPayload
+	if err := json.Unmarshal(request.body, &actual); err != nil {
+		t.Fatal(err)
+	}
+	if diff := pretty.Compare(payload, actual); diff != "" {
+		t.Errorf("Unexpected payload, diff: (-want +got)\n%s", diff)
+	}
+}
+
+func TestSendWebhookClientError(t *testing.T) {
+	webhookRetryDelay = time.Millisecond
+	server, requests := newWebhookServer(http.StatusBadRequest, http.StatusOK)
+	defer server.Close()
+
+	conf := config.ServerConfig{WebhookURL: server.URL, WebhookRetries: 3}
+	sendWebhook(conf, webhookPayload{Event: "success"}, testLogger)
+
+	// Client errors are not retried
+	receiveWebhook(t, requests)
+	if len(requests) != 0 {
+		t.Errorf("Expected no retry, got %d more request(s)", len(requests))
+	}
+}
+
+func TestNotifyCompletionStateChangesOnly(t *testing.T) {
+	server, requests := newWebhookServer()
+	defer server.Close()
+
+	s := &state.Server{Config: config.ServerConfig{SectionName: "server1", WebhookURL: server.URL, WebhookStateChangesOnly: true}, HealthMutex: &sync.Mutex{}}
+
+	outcomes := []error{nil, fmt.Errorf("timeout"), fmt.Errorf("timeout"), nil, nil}
+	for _, err := range outcomes {
+		stateChanged := s.RecordRun("activity", err)
+		notifyCompletion(s, "activity", time.Now(), err, nil, stateChanged, testLogger)
+	}
+
+	var events []string
+	for i := 0; i < 2; i++ {
+		var payload webhookPayload
+		if err := json.Unmarshal(receiveWebhook(t, requests).body, &payload); err != nil {
+			t.Fatal(err)
+		}
+		events = append(events, payload.Event+" "+payload.StateChange)
+	}
+	time.Sleep(50 * time.Millisecond)
+	if len(requests) != 0 {
+		t.Errorf("Expected 2 webhooks, got %d more", len(requests))
+	}
+
+	// Webhooks are sent in the background, so they may arrive in any order
+	if !(events[0] == "error failing" && events[1] == "success recovered") && !(events[1] == "error failing" && events[0] == "success recovered") {
+		t.Errorf("Expected a failing and a recovered webhook, got %v", events)
+	}
+}
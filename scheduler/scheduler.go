@@ -86,6 +86,16 @@ func GetSchedulerGroups() (groups map[string]Group, err error) {
 		return
 	}
 
+	fiveMinuteInterval, err := cronexpr.Parse("0 */5 * * * * *")
+	if err != nil {
+		return
+	}
+
+	oneHourInterval, err := cronexpr.Parse("0 0 * * * * *")
+	if err != nil {
+		return
+	}
+
 	groups = make(map[string]Group)
 
 	groups["stats"] = Group{interval: tenMinuteInterval}
@@ -93,6 +103,8 @@ func GetSchedulerGroups() (groups map[string]Group, err error) {
 	groups["logs"] = Group{interval: thirtySecondInterval}
 	groups["activity"] = Group{interval: tenSecondInterval}
 	groups["query_stats"] = Group{interval: oneMinuteInterval}
+	groups["local_reports"] = Group{interval: oneHourInterval}
+	groups["discovery"] = Group{interval: fiveMinuteInterval}
 
 	return
 }
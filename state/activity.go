@@ -8,9 +8,13 @@ type TransientActivityState struct {
 	Version  PostgresVersion
 	Backends []PostgresBackend
 
-	Vacuums []PostgresVacuumProgress
+	Vacuums  []PostgresVacuumProgress
+	Progress []PostgresProgress // Other operations reporting progress (CREATE INDEX, ANALYZE, CLUSTER, COPY, base backups)
 }
 
 type PersistedActivityState struct {
 	ActivitySnapshotAt time.Time
-}
\ No newline at end of file
+
+	// Current phase of each operation reporting progress, keyed by ProgressIdentity
+	ProgressPhases map[uint64]ProgressPhase
+}
@@ -0,0 +1,94 @@
+package state
+
+import (
+	"net"
+	"strconv"
+	"time"
+)
+
+// Roles of high availability cluster members
+const (
+	HARolePrimary     = "primary"
+	HARoleReplica     = "replica"
+	HARoleUnreachable = "unreachable"
+)
+
+// HARoleChangeLogSize - Number of role changes kept in the rolling change log
+const HARoleChangeLogSize = 100
+
+// HAMember - Member of a high availability cluster, with the role it had when the
+// cluster was last checked
+type HAMember struct {
+	Name string // Patroni member name (empty for members listed in db_ha_members)
+	Host string
+	Port int
+	Role string // One of the HARole* constants
+}
+
+// Address - Returns the host and port of the member, e.g. "10.0.0.1:5432"
+func (member HAMember) Address() string {
+	return net.JoinHostPort(member.Host, strconv.Itoa(member.Port))
+}
+
+// HAState - Members of a high availability cluster, and the one the collector connects to
+type HAState struct {
+	Members   []HAMember
+	Target    HAMember
+	CheckedAt time.Time
+
+	// Role changes detected since the last full snapshot (moved to PersistedState.HARoleChanges)
+	PendingRoleChanges []HARoleChange
+}
+
+// HARoleChange - Switch of the collector to a different cluster member, e.g. after a failover
+type HARoleChange struct {
+	DetectedAt     time.Time
+	Role           string // The role the collector follows (primary or replica)
+	PreviousMember string
+	NewMember      string
+	Members        []HAMember // All members with their roles at the time of the change
+}
+
+// GetHATarget - Returns the cluster member the collector currently connects to, and
+// false if the cluster members have not been checked yet
+func (server *Server) GetHATarget() (HAMember, bool) {
+	server.HAMutex.Lock()
+	defer server.HAMutex.Unlock()
+
+	return server.HA.Target, !server.HA.CheckedAt.IsZero()
+}
+
+// SetHAMembers - Remembers the current cluster members, and records a role change if the
+// collector now connects to a different member than before
+func (server *Server) SetHAMembers(members []HAMember, target HAMember, role string) (changed bool, previous HAMember) {
+	server.HAMutex.Lock()
+	defer server.HAMutex.Unlock()
+
+	previous = server.HA.Target
+	changed = !server.HA.CheckedAt.IsZero() && previous.Address() != target.Address()
+	if changed {
+		server.HA.PendingRoleChanges = append(server.HA.PendingRoleChanges, HARoleChange{
+			DetectedAt:     time.Now(),
+			Role:           role,
+			PreviousMember: previous.Address(),
+			NewMember:      target.Address(),
+			Members:        members,
+		})
+	}
+
+	server.HA.Members = members
+	server.HA.Target = target
+	server.HA.CheckedAt = time.Now()
+
+	return
+}
+
+// TakeHARoleChanges - Returns the role changes detected since the last call
+func (server *Server) TakeHARoleChanges() []HARoleChange {
+	server.HAMutex.Lock()
+	defer server.HAMutex.Unlock()
+
+	changes := server.HA.PendingRoleChanges
+	server.HA.PendingRoleChanges = nil
+	return changes
+}
@@ -0,0 +1,80 @@
+package state
+
+import "time"
+
+// RunStatus - Outcome of the most recent runs of one type of collection
+type RunStatus struct {
+	LastAttemptAt time.Time
+	LastSuccessAt time.Time
+	LastError     string
+}
+
+// ServerHealth - Collection status of a server, as shown by the status endpoint
+//
+// Runs are keyed by the same names used for the error/success callbacks
+// ("full", "activity", "query_stats", "logs" and "reports").
+type ServerHealth struct {
+	Runs map[string]RunStatus
+
+	GrantValid bool
+
+	LogTailActive bool
+	LogTailError  string
+}
+
+// RecordRun - Remembers the outcome of a collection run (err is nil if it succeeded), and
+// returns whether the run type started failing, or succeeded again after failing before
+func (server *Server) RecordRun(runType string, err error) (stateChanged bool) {
+	server.HealthMutex.Lock()
+	defer server.HealthMutex.Unlock()
+
+	if server.Health.Runs == nil {
+		server.Health.Runs = make(map[string]RunStatus)
+	}
+	status := server.Health.Runs[runType]
+	wasFailing := status.LastError != ""
+	stateChanged = wasFailing != (err != nil)
+
+	status.LastAttemptAt = time.Now()
+	if err != nil {
+		status.LastError = err.Error()
+	} else {
+		status.LastSuccessAt = status.LastAttemptAt
+		status.LastError = ""
+	}
+	server.Health.Runs[runType] = status
+	return
+}
+
+// RecordGrant - Remembers whether the last grant received from the pganalyze API was valid
+func (server *Server) RecordGrant(grant Grant) {
+	server.HealthMutex.Lock()
+	server.Health.GrantValid = grant.Valid
+	server.HealthMutex.Unlock()
+}
+
+// RecordLogTail - Remembers whether the local log tail could be set up (err is nil if it is running)
+func (server *Server) RecordLogTail(err error) {
+	server.HealthMutex.Lock()
+	defer server.HealthMutex.Unlock()
+
+	server.Health.LogTailActive = err == nil
+	if err != nil {
+		server.Health.LogTailError = err.Error()
+	} else {
+		server.Health.LogTailError = ""
+	}
+}
+
+// GetHealth - Returns a copy of the current collection status of the server
+func (server *Server) GetHealth() ServerHealth {
+	server.HealthMutex.Lock()
+	defer server.HealthMutex.Unlock()
+
+	health := server.Health
+	health.Runs = make(map[string]RunStatus, len(server.Health.Runs))
+	for runType, status := range server.Health.Runs {
+		health.Runs[runType] = status
+	}
+	return health
+}
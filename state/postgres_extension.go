@@ -0,0 +1,87 @@
+package state
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/guregu/null"
+)
+
+// PostgresExtension - Extension installed in a PostgreSQL database
+type PostgresExtension struct {
+	DatabaseOid       Oid
+	ExtensionName     string
+	Version           string      // Installed version (pg_extension.extversion)
+	SchemaName        string      // Schema containing the extension's objects
+	DefaultVersion    null.String // Version a new CREATE EXTENSION would install, null if the extension files were removed
+	AvailableVersions []string    // All versions the installed extension files provide
+}
+
+// Outdated - Whether the installed version is older than the default version
+// provided by the extension files on the server, i.e. ALTER EXTENSION ... UPDATE
+// was not run after a package upgrade
+func (e PostgresExtension) Outdated() bool {
+	return e.DefaultVersion.Valid && CompareExtensionVersions(e.Version, e.DefaultVersion.String) < 0
+}
+
+// CompareExtensionVersions - Orders two extension versions, returning -1, 0 or 1
+//
+// Extension versions are free-form strings, so this splits them into runs of
+// digits and other characters, and compares digit runs numerically (so "1.10"
+// is newer than "1.9"), and everything else as plain strings.
+func CompareExtensionVersions(a string, b string) int {
+	aParts := splitVersion(a)
+	bParts := splitVersion(b)
+	for i := 0; i < len(aParts) && i < len(bParts); i++ {
+		if aParts[i] == bParts[i] {
+			continue
+		}
+		aNum, aErr := strconv.ParseUint(aParts[i], 10, 64)
+		bNum, bErr := strconv.ParseUint(bParts[i], 10, 64)
+		if aErr == nil && bErr == nil {
+			if aNum < bNum {
+				return -1
+			} else if aNum > bNum {
+				return 1
+			}
+			continue
+		}
+		if aParts[i] < bParts[i] {
+			return -1
+		}
+		return 1
+	}
+	if len(aParts) < len(bParts) {
+		return -1
+	} else if len(aParts) > len(bParts) {
+		return 1
+	}
+	return 0
+}
+
+func splitVersion(version string) []string {
+	var parts []string
+	var current strings.Builder
+	currentIsDigit := false
+	for _, r := range version {
+		if r == '.' || r == '-' || r == '_' {
+			if current.Len() > 0 {
+				parts = append(parts, current.String())
+				current.Reset()
+			}
+			continue
+		}
+		isDigit := unicode.IsDigit(r)
+		if current.Len() > 0 && isDigit != currentIsDigit {
+			parts = append(parts, current.String())
+			current.Reset()
+		}
+		currentIsDigit = isDigit
+		current.WriteRune(r)
+	}
+	if current.Len() > 0 {
+		parts = append(parts, current.String())
+	}
+	return parts
+}
@@ -0,0 +1,49 @@
+package state_test
+
+import (
+	"testing"
+
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/state"
+)
+
+var compareExtensionVersionsTests = []struct {
+	a        string
+	b        string
+	expected int
+}{
+	{"1.0", "1.0", 0},
+	{"1.9", "1.10", -1},
+	{"1.10", "1.9", 1},
+	{"2.5.4", "2.5", 1},
+	{"1.0beta1", "1.0beta2", -1},
+}
+
+func TestCompareExtensionVersions(t *testing.T) {
+	for _, test := range compareExtensionVersionsTests {
+		actual := state.CompareExtensionVersions(test.a, test.b)
+		if actual != test.expected {
+			t.Errorf("CompareExtensionVersions(%q, %q): expected %d, got %d", test.a, test.b, test.expected, actual)
+		}
+	}
+}
+
+var extensionOutdatedTests = []struct {
+	version        string
+	defaultVersion null.String
+	expected       bool
+}{
+	{"1.7", null.StringFrom("1.8"), true},
+	{"1.8", null.StringFrom("1.8"), false},
+	{"1.10", null.StringFrom("1.9"), false}, // Installed from a newer package than the one on disk
+	{"1.7", null.String{}, false},
+}
+
+func TestExtensionOutdated(t *testing.T) {
+	for _, test := range extensionOutdatedTests {
+		extension := state.PostgresExtension{ExtensionName: "pg_stat_statements", Version: test.version, DefaultVersion: test.defaultVersion}
+		if actual := extension.Outdated(); actual != test.expected {
+			t.Errorf("Outdated() for version %q with default %v: expected %v, got %v", test.version, test.defaultVersion, test.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package state
+
+import "github.com/guregu/null"
+
+// Index health issues, in order of precedence (each index is only reported once)
+const (
+	IndexHealthInvalid             = "invalid"
+	IndexHealthDuplicate           = "duplicate"
+	IndexHealthCoveredByPrimaryKey = "covered_by_primary_key"
+	IndexHealthPrefixDuplicate     = "prefix_duplicate"
+	IndexHealthUnused              = "unused"
+)
+
+type PostgresIndexHealthIssue struct {
+	DatabaseName string
+	SchemaName   string
+	RelationName string
+	IndexName    string
+	IndexDef     string
+	Issue        string // One of the IndexHealth* constants
+	SizeBytes    int64
+	IdxScan      int64  // Number of index scans since statistics were last reset
+	CoveredBy    string // For duplicates, the name of the index that makes this index redundant
+}
+
+type PostgresIndexHealthDatabase struct {
+	DatabaseName  string
+	StatsResetAt  null.Time // When statistics of this database were last reset, null if never
+	CheckedUnused bool      // False if statistics were reset too recently to determine unused indexes
+}
+
+type PostgresIndexHealthReport struct {
+	Databases      []PostgresIndexHealthDatabase
+	Issues         []PostgresIndexHealthIssue
+	TotalSizeBytes int64 // Combined size of all indexes with issues
+}
@@ -0,0 +1,98 @@
+package state
+
+import (
+	"time"
+
+	"github.com/guregu/null"
+)
+
+// Commands reported through PostgresProgress, based on the pg_stat_progress_* view they come from
+const (
+	ProgressCommandCreateIndex = "CREATE INDEX"
+	ProgressCommandAnalyze     = "ANALYZE"
+	ProgressCommandCluster     = "CLUSTER"
+	ProgressCommandCopy        = "COPY"
+	ProgressCommandBaseBackup  = "BASE_BACKUP"
+)
+
+// PostgresProgress - Long-running PostgreSQL operation (other than VACUUM) that reports its progress
+//
+// See https://www.postgresql.org/docs/current/progress-reporting.html
+type PostgresProgress struct {
+	ProgressIdentity uint64 // Combination of "query" start time and PID, used to identify an operation over time
+	BackendIdentity  uint64 // Combination of process start time and PID, used to identify a process over time
+
+	Command       string // One of the ProgressCommand* values
+	CommandDetail string // Sub-command as reported by Postgres, e.g. "CREATE INDEX CONCURRENTLY", "VACUUM FULL" or "COPY FROM"
+	DatabaseName  string
+	SchemaName    string
+	RelationName  string
+	IndexName     string // CREATE INDEX: Index being built, CLUSTER: Index used for ordering
+	RoleName      string
+	StartedAt     time.Time
+	Phase         string
+	Pid           int32
+
+	// Generalized progress counters - not every command reports all of these, unknown totals are zero
+	BlocksTotal int64
+	BlocksDone  int64
+	TuplesTotal int64
+	TuplesDone  int64
+	BytesTotal  int64
+	BytesDone   int64
+
+	// When the collector first saw the operation in its current phase, and how far along
+	// the phase was at that time (used to estimate the remaining time of the phase)
+	PhaseObservedAt       time.Time
+	PhaseObservedFraction null.Float
+}
+
+// ProgressPhase - Phase an operation was in when last seen by the collector, kept between
+// activity snapshots to estimate the progress rate of each phase
+type ProgressPhase struct {
+	Phase      string
+	ObservedAt time.Time
+	Fraction   null.Float
+}
+
+// Fraction - Returns the fraction of work completed (0 to 1), based on the most
+// precise counter the command reports, or an invalid value if its unknown
+func (p PostgresProgress) Fraction() null.Float {
+	if p.BlocksTotal > 0 {
+		return null.FloatFrom(clampFraction(float64(p.BlocksDone) / float64(p.BlocksTotal)))
+	}
+	if p.TuplesTotal > 0 {
+		return null.FloatFrom(clampFraction(float64(p.TuplesDone) / float64(p.TuplesTotal)))
+	}
+	if p.BytesTotal > 0 {
+		return null.FloatFrom(clampFraction(float64(p.BytesDone) / float64(p.BytesTotal)))
+	}
+	return null.Float{}
+}
+
+// EstimatedTimeRemaining - Extrapolates the remaining runtime of the current phase, based
+// on the progress made since the collector first saw the operation in this phase
+//
+// Multi-phase operations (e.g. CREATE INDEX) report progress per phase, so the estimate
+// only covers the current phase, and is unknown until the phase has been seen twice.
+func (p PostgresProgress) EstimatedTimeRemaining(now time.Time) (time.Duration, bool) {
+	fraction := p.Fraction()
+	if !fraction.Valid || !p.PhaseObservedFraction.Valid || p.PhaseObservedAt.IsZero() {
+		return 0, false
+	}
+
+	elapsed := now.Sub(p.PhaseObservedAt)
+	progress := fraction.Float64 - p.PhaseObservedFraction.Float64
+	if elapsed <= 0 || progress <= 0 {
+		return 0, false
+	}
+
+	return time.Duration(float64(elapsed) * (1 - fraction.Float64) / progress), true
+}
+
+func clampFraction(f float64) float64 {
+	if f > 1 {
+		return 1
+	}
+	return f
+}
@@ -0,0 +1,56 @@
+package state
+
+import "time"
+
+// PostgresPlanNode - Node of a query plan, as parsed from EXPLAIN (FORMAT JSON) output
+type PostgresPlanNode struct {
+	NodeType           string `json:"Node Type"`
+	Operation          string `json:"Operation"` // ModifyTable only
+	Strategy           string `json:"Strategy"`  // Agg and SetOp only
+	JoinType           string `json:"Join Type"`
+	ParentRelationship string `json:"Parent Relationship"`
+	SubplanName        string `json:"Subplan Name"`
+	ScanDirection      string `json:"Scan Direction"`
+	Schema             string `json:"Schema"`
+	RelationName       string `json:"Relation Name"`
+	IndexName          string `json:"Index Name"`
+	CTEName            string `json:"CTE Name"`
+	FunctionName       string `json:"Function Name"`
+
+	// Estimates and actuals, ignored for the plan fingerprint
+	TotalCost       float64 `json:"Total Cost"`
+	PlanRows        float64 `json:"Plan Rows"`
+	ActualTotalTime float64 `json:"Actual Total Time"` // Only set for EXPLAIN ANALYZE (log_analyze in auto_explain)
+	ActualRows      float64 `json:"Actual Rows"`
+	ActualLoops     float64 `json:"Actual Loops"`
+
+	Plans []PostgresPlanNode `json:"Plans"`
+}
+
+// QueryPlanKey - Identifies a query whose plans are tracked across log snapshots
+type QueryPlanKey struct {
+	Database         string
+	QueryFingerprint [21]byte
+}
+
+// QueryPlanStats - Statistics about one plan that was seen for a query
+type QueryPlanStats struct {
+	PlanFingerprint string
+	NodeSummary     []string
+
+	FirstSeenAt time.Time
+	LastSeenAt  time.Time
+
+	Count          int64
+	TotalRuntimeMs float64
+	MaxRuntimeMs   float64
+}
+
+// QueryPlanHistory - All plans that were seen for a query, and the most recent one
+//
+// This is only kept in memory, and starts out empty when the collector is restarted.
+type QueryPlanHistory struct {
+	Plans               []QueryPlanStats
+	LastPlanFingerprint string
+	LastSeenAt          time.Time
+}
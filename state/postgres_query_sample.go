@@ -24,6 +24,12 @@ type PostgresQuerySample struct {
 	ExplainFormat pganalyze_collector.QuerySample_ExplainFormat
 	ExplainSource pganalyze_collector.QuerySample_ExplainSource
 
+	// Set for auto_explain JSON plans, see logs.TrackQueryPlans
+	PlanFingerprint         string
+	PlanNodes               []string // One line summary of each plan node, depth-first
+	PlanChanged             bool     // Plan differs from the last plan seen for this query
+	PreviousPlanFingerprint string
+
 	// FUTURE: Could use parameters (and query values) to determine whether
 	// the given value is included in most_common_vals (and which most_common_freqs it has)
 }
@@ -5,6 +5,13 @@ type PostgresRelationBloat struct {
 	RelationName string
 	TotalBytes   int64
 	BloatBytes   int64
+
+	// Only set when measured using pgstattuple_approx, instead of being estimated
+	Exact          bool
+	LiveTupleBytes int64
+	LiveTupleCount int64
+	DeadTupleBytes int64
+	DeadTupleCount int64
 }
 
 type PostgresIndexBloat struct {
@@ -12,6 +19,14 @@ type PostgresIndexBloat struct {
 	IndexName  string
 	TotalBytes int64
 	BloatBytes int64
+
+	// Only set when measured using pgstatindex, instead of being estimated
+	Exact          bool
+	InternalPages  int64
+	LeafPages      int64
+	EmptyPages     int64
+	DeletedPages   int64
+	AvgLeafDensity float64
 }
 
 type PostgresBloatStats struct {
@@ -16,6 +16,10 @@ type PostgresRelation struct {
 	Columns                []PostgresColumn
 	Indices                []PostgresIndex
 	Constraints            []PostgresConstraint
+	ExtendedStats          []PostgresExtendedStatistic
+	Triggers               []PostgresTrigger
+	Rules                  []PostgresRule
+	Publications           []string // Logical replication publications that include this table (Postgres 10+)
 	ViewDefinition         string
 	Options                map[string]string
 	HasOids                bool
@@ -41,6 +45,30 @@ type PostgresColumn struct {
 	DefaultValue null.String
 	NotNull      bool
 	Position     int32
+	Stats        []PostgresColumnStats // Planner statistics, one entry per value of "inherited" (if ANALYZE ran)
+}
+
+// PostgresColumnStats - Summary of the planner statistics for a column (pg_stats)
+//
+// Note that we intentionally don't collect most common values or histogram
+// bounds, since those contain actual table data.
+type PostgresColumnStats struct {
+	Inherited   bool       // If true, statistics include values from child tables
+	NullFrac    float64    // Fraction of column entries that are null
+	AvgWidth    int32      // Average width in bytes of column's entries
+	NDistinct   float64    // If greater than zero, the estimated number of distinct values, if less than zero, the negative of the number of distinct values divided by the number of rows
+	Correlation null.Float // Statistical correlation between physical row ordering and logical ordering of the column values
+}
+
+// PostgresExtendedStatistic - Extended statistics object defined on a table (CREATE STATISTICS), Postgres 10+
+type PostgresExtendedStatistic struct {
+	RelationOid  Oid
+	SchemaName   string      // Schema the statistics object belongs to (not necessarily the table's schema)
+	Name         string      // Name of the statistics object
+	Definition   string      // CREATE STATISTICS command, as returned by pg_get_statisticsobjdef
+	Kinds        []string    // Enabled statistics kinds: d = n-distinct, f = functional dependencies, m = most common values, e = expressions
+	NDistinct    null.String // Computed n-distinct counts for column combinations (pg_ndistinct text format), if ANALYZE ran
+	Dependencies null.String // Computed functional dependencies between columns (pg_dependencies text format), if ANALYZE ran
 }
 
 type PostgresIndex struct {
@@ -70,6 +98,37 @@ type PostgresConstraint struct {
 	ForeignMatchType  string  // Foreign key match type: f = full, p = partial, s = simple
 }
 
+type PostgresTrigger struct {
+	RelationOid    Oid    // The table this trigger is on
+	Name           string // Trigger name
+	Definition     string // CREATE TRIGGER command, as returned by pg_get_triggerdef
+	Enabled        string // O = fires in "origin" and "local" modes, D = disabled, R = fires in "replica" mode, A = fires always
+	IsInternal     bool   // True if the trigger was created implicitly, e.g. to enforce a foreign key constraint
+	FunctionSchema string // Schema of the function called by the trigger
+	FunctionName   string // Name of the function called by the trigger
+}
+
+type PostgresRule struct {
+	RelationOid Oid    // The table or view this rule is on
+	Name        string // Rule name
+	Definition  string // CREATE RULE command, as returned by pg_get_ruledef
+	Event       string // 1 = SELECT, 2 = UPDATE, 3 = INSERT, 4 = DELETE
+	IsInstead   bool   // True if the rule is an INSTEAD rule
+	Enabled     string // O = fires in "origin" and "local" modes, D = disabled, R = fires in "replica" mode, A = fires always
+}
+
+// PostgresEventTrigger - Database-wide trigger on DDL events (Postgres 9.3+)
+type PostgresEventTrigger struct {
+	DatabaseOid    Oid
+	Name           string
+	Event          string   // e.g. ddl_command_start, ddl_command_end, sql_drop, table_rewrite
+	Owner          string   // Role that owns the event trigger
+	FunctionSchema string   // Schema of the function called by the event trigger
+	FunctionName   string   // Name of the function called by the event trigger
+	Enabled        string   // O = fires in "origin" and "local" modes, D = disabled, R = fires in "replica" mode, A = fires always
+	Tags           []string // Command tags the trigger fires for, empty if it fires for all commands
+}
+
 // Fillfactor - Returns the FILLFACTOR storage parameter set on the table, or the default (100)
 func (r PostgresRelation) Fillfactor() int32 {
 	fstr, exists := r.Options["fillfactor"]
@@ -0,0 +1,35 @@
+package state
+
+import "time"
+
+// Schema object types that changes are detected for
+const (
+	SchemaObjectRelation   = "relation"
+	SchemaObjectColumn     = "column"
+	SchemaObjectIndex      = "index"
+	SchemaObjectConstraint = "constraint"
+	SchemaObjectFunction   = "function"
+)
+
+// Types of schema changes
+const (
+	SchemaChangeAdded   = "added"
+	SchemaChangeDropped = "dropped"
+	SchemaChangeAltered = "altered"
+)
+
+// SchemaChangeLogSize - Number of schema changes kept in the rolling change log
+const SchemaChangeLogSize = 1000
+
+// PostgresSchemaChange - Schema change detected between two consecutive full snapshots
+type PostgresSchemaChange struct {
+	DetectedAt   time.Time
+	DatabaseOid  Oid
+	DatabaseName string
+	ObjectType   string // One of the SchemaObject* constants
+	ChangeType   string // One of the SchemaChange* constants
+	SchemaName   string
+	RelationName string   // Empty for functions
+	ObjectName   string   // Column, index or constraint name, or function signature (empty for relations)
+	Details      []string // What was altered, e.g. "type changed from integer to bigint"
+}
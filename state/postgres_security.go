@@ -0,0 +1,72 @@
+package state
+
+import "github.com/guregu/null"
+
+// Object types that privileges are collected for
+const (
+	PrivilegeObjectTable    = "table"
+	PrivilegeObjectSchema   = "schema"
+	PrivilegeObjectFunction = "function"
+)
+
+// PostgresPrivilege - A single ACL entry on a table, schema or function, decoded
+// from relacl/nspacl/proacl (objects without an explicit ACL use the default ACL)
+type PostgresPrivilege struct {
+	DatabaseOid Oid
+	ObjectType  string // One of the PrivilegeObject* constants
+	ObjectOid   Oid
+	SchemaName  string
+	ObjectName  string // Empty for schemas; includes the argument list for functions
+	Grantor     string
+	Grantee     string // Role name, or "PUBLIC" for privileges granted to all roles
+	Privilege   string // e.g. SELECT, INSERT, USAGE, EXECUTE
+	Grantable   bool   // WITH GRANT OPTION
+}
+
+// PostgresRoleMembership - Membership of a role in another role (pg_auth_members)
+type PostgresRoleMembership struct {
+	RoleOid     Oid // The role that has a member
+	MemberOid   Oid // The role that is a member of RoleOid
+	GrantorOid  Oid
+	AdminOption bool // Member can grant membership in RoleOid to others
+}
+
+// PostgresPolicy - Row level security policy on a table (pg_policy)
+type PostgresPolicy struct {
+	DatabaseOid Oid
+	RelationOid Oid
+	SchemaName  string
+	TableName   string
+	Name        string
+	Command     string      // r = SELECT, a = INSERT, w = UPDATE, d = DELETE, * = ALL
+	Permissive  bool        // False for RESTRICTIVE policies (always true before Postgres 10)
+	Roles       []string    // Roles the policy applies to, "public" for all roles
+	Using       null.String // USING expression
+	WithCheck   null.String // WITH CHECK expression
+}
+
+// PostgresHbaRule - Line in pg_hba.conf as seen by the server (pg_hba_file_rules)
+type PostgresHbaRule struct {
+	LineNumber int32
+	Type       null.String // local, host, hostssl, hostnossl (and hostgssenc/hostnogssenc)
+	Databases  []string
+	UserNames  []string
+	Address    null.String
+	Netmask    null.String
+	AuthMethod null.String
+	Options    []string
+	Error      null.String // Set if the line could not be parsed, the rule is not in effect
+}
+
+// PostgresSecurityInfo - Privileges, role memberships, row level security policies
+// and authentication rules, only collected when enable_security_info is set
+type PostgresSecurityInfo struct {
+	Privileges      []PostgresPrivilege
+	RoleMemberships []PostgresRoleMembership
+	Policies        []PostgresPolicy
+	HbaRules        []PostgresHbaRule
+
+	// Password encryption method by role (md5, scram-sha-256 or plain), only
+	// available when the collector can read pg_authid
+	PasswordMethods map[Oid]string
+}
@@ -3,12 +3,68 @@ package state
 import "github.com/guregu/null"
 
 type PostgresSetting struct {
-	Name         string      `json:"name"`
-	CurrentValue null.String `json:"current_value"`
-	Unit         null.String `json:"unit"`
-	BootValue    null.String `json:"boot_value"`
-	ResetValue   null.String `json:"reset_value"`
-	Source       null.String `json:"source"`
-	SourceFile   null.String `json:"sourcefile"`
-	SourceLine   null.String `json:"sourceline"`
+	Name           string      `json:"name"`
+	CurrentValue   null.String `json:"current_value"`
+	Unit           null.String `json:"unit"`
+	BootValue      null.String `json:"boot_value"`
+	ResetValue     null.String `json:"reset_value"`
+	Source         null.String `json:"source"`
+	SourceFile     null.String `json:"sourcefile"`
+	SourceLine     null.String `json:"sourceline"`
+	PendingRestart bool        `json:"pending_restart"` // Value was changed in the configuration file but requires a restart (Postgres 9.5+)
+}
+
+// PostgresSettingChange - Difference in a setting between two snapshots (or two servers)
+type PostgresSettingChange struct {
+	Name           string
+	PreviousValue  null.String // Null if the setting did not exist before (e.g. an extension was loaded)
+	CurrentValue   null.String // Null if the setting does not exist anymore
+	Unit           null.String
+	SourceFile     null.String // Configuration file the current value comes from, if any
+	SourceLine     null.String
+	PendingRestart bool
+}
+
+// PostgresSettingFileError - Entry in a configuration file that could not be applied (pg_file_settings)
+type PostgresSettingFileError struct {
+	SourceFile string
+	SourceLine int32
+	Name       null.String
+	Error      string
+}
+
+// DiffSettings - Returns all settings whose value or pending restart status differs
+func DiffSettings(prev []PostgresSetting, new []PostgresSetting) (changes []PostgresSettingChange) {
+	prevByName := make(map[string]PostgresSetting, len(prev))
+	for _, setting := range prev {
+		prevByName[setting.Name] = setting
+	}
+
+	for _, setting := range new {
+		prevSetting, exists := prevByName[setting.Name]
+		delete(prevByName, setting.Name)
+		if exists && prevSetting.CurrentValue == setting.CurrentValue && prevSetting.PendingRestart == setting.PendingRestart {
+			continue
+		}
+		change := PostgresSettingChange{
+			Name:           setting.Name,
+			CurrentValue:   setting.CurrentValue,
+			Unit:           setting.Unit,
+			SourceFile:     setting.SourceFile,
+			SourceLine:     setting.SourceLine,
+			PendingRestart: setting.PendingRestart,
+		}
+		if exists {
+			change.PreviousValue = prevSetting.CurrentValue
+		}
+		changes = append(changes, change)
+	}
+
+	for _, setting := range prev {
+		if _, removed := prevByName[setting.Name]; removed {
+			changes = append(changes, PostgresSettingChange{Name: setting.Name, PreviousValue: setting.CurrentValue, Unit: setting.Unit})
+		}
+	}
+
+	return
 }
@@ -11,6 +11,9 @@ const (
 	PostgresVersion11 = 110000
 	PostgresVersion12 = 120000
 	PostgresVersion13 = 130000
+	PostgresVersion14 = 140000
+	PostgresVersion15 = 150000
+	PostgresVersion16 = 160000
 
 	// MinRequiredPostgresVersion - We require PostgreSQL 9.2 or newer, since pg_stat_statements only started being usable then
 	MinRequiredPostgresVersion = PostgresVersion92
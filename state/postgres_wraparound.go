@@ -0,0 +1,75 @@
+package state
+
+import (
+	"time"
+
+	"github.com/guregu/null"
+)
+
+// XidWraparoundLimit - Transaction IDs (as well as multixact IDs) are compared
+// using modulo-2^32 arithmetic, so a frozen XID can be at most 2^31 old before
+// it would appear to be in the future. Postgres refuses to assign new IDs a few
+// million transactions before reaching this.
+const XidWraparoundLimit = 1 << 31
+
+// PostgresXidSample - Position of the transaction ID and multixact ID counters at a
+// point in time, used to determine how fast they are being consumed
+type PostgresXidSample struct {
+	SampledAt       time.Time
+	NextXid         int64    // Next transaction ID to be assigned, including the epoch
+	NextMultiXactID null.Int // Next multixact ID to be assigned (as of the last checkpoint), if known
+}
+
+// Horizon holder types, i.e. what is preventing VACUUM from removing old row versions and freezing
+const (
+	XminHorizonTransaction         = "transaction"
+	XminHorizonPreparedTransaction = "prepared_transaction"
+	XminHorizonReplicationSlot     = "replication_slot"
+	XminHorizonStandbyFeedback     = "standby_feedback"
+)
+
+type PostgresXminHorizonHolder struct {
+	Type         string      // One of the XminHorizon* constants
+	Name         string      // Backend PID, prepared transaction GID, replication slot name or standby application name
+	DatabaseName null.String // Database the holder is connected to, if applicable
+	RoleName     null.String // Role the holder runs as, if applicable
+	StartedAt    null.Time   // Start of the transaction, or when the prepared transaction was prepared
+	XminAge      int64       // Age of the oldest transaction ID the holder needs to be kept around
+}
+
+type PostgresWraparoundDatabase struct {
+	DatabaseName string
+	XidAge       int64    // age(datfrozenxid)
+	MxidAge      null.Int // mxid_age(datminmxid), Postgres 9.5+
+}
+
+type PostgresWraparoundRelation struct {
+	DatabaseName           string
+	SchemaName             string
+	RelationName           string
+	IsToast                bool     // If true, the ages apply to the TOAST table of this relation
+	XidAge                 int64    // age(relfrozenxid)
+	MxidAge                null.Int // mxid_age(relminmxid), Postgres 9.5+
+	AutovacuumFreezeMaxAge int64    // Effective setting, taking the table's storage parameters into account
+}
+
+type PostgresWraparoundReport struct {
+	AutovacuumFreezeMaxAge          int64
+	AutovacuumMultixactFreezeMaxAge int64
+
+	// Ordered by risk, most at risk first
+	Databases []PostgresWraparoundDatabase
+	Relations []PostgresWraparoundRelation
+
+	XminHorizonHolders []PostgresXminHorizonHolder
+
+	// Consumption rate since the last full snapshot, and the time remaining until the
+	// oldest database reaches autovacuum_freeze_max_age (which forces an anti-wraparound
+	// autovacuum) or the wraparound limit. Null if there is no earlier sample.
+	XidsPerSecond                       null.Float
+	MxidsPerSecond                      null.Float
+	SecondsUntilEmergencyAutovacuum     null.Float
+	SecondsUntilWraparound              null.Float
+	MxidSecondsUntilEmergencyAutovacuum null.Float
+	MxidSecondsUntilWraparound          null.Float
+}
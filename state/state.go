@@ -21,8 +21,16 @@ type PersistedState struct {
 	StatementStats PostgresStatementStatsMap
 	SchemaStats    map[Oid]*SchemaStats
 
-	Relations []PostgresRelation
-	Functions []PostgresFunction
+	Relations     []PostgresRelation
+	Functions     []PostgresFunction
+	Extensions    []PostgresExtension
+	EventTriggers []PostgresEventTrigger
+
+	// Kept across runs to detect configuration changes
+	Settings []PostgresSetting
+
+	// Rolling log of schema changes detected between full snapshots, oldest first
+	SchemaChanges []PostgresSchemaChange
 
 	System         SystemState
 	CollectorStats CollectorStats
@@ -35,8 +43,19 @@ type PersistedState struct {
 	// Keep track of when we last collected statement stats, to calculate time distance
 	LastStatementStatsAt time.Time
 
+	// Transaction ID counter position, used to calculate the XID consumption rate
+	XidSample PostgresXidSample
+
 	// All statement stats that have not been identified (will be cleared by the next full snapshot)
 	UnidentifiedStatementStats HistoricStatementStatsMap
+
+	// High availability clusters only: The database system identifier (shared by the primary
+	// and its physical replicas), and the member the statistics were collected from
+	SystemIdentifier string
+	HAMember         string
+
+	// Rolling log of role changes followed by the collector (e.g. failovers), oldest first
+	HARoleChanges []HARoleChange
 }
 
 // TransientState - State thats only used within a collector run (and not needed for diffs)
@@ -47,6 +66,9 @@ type TransientState struct {
 	Roles     []PostgresRole
 	Databases []PostgresDatabase
 
+	// Only collected when enable_security_info is set
+	Security PostgresSecurityInfo
+
 	Statements             PostgresStatementMap
 	StatementTexts         PostgresStatementTextMap
 	HistoricStatementStats HistoricStatementStatsMap
@@ -55,9 +77,9 @@ type TransientState struct {
 	// in order to enable the next snapshot to be able to diff against something
 	ResetStatementStats PostgresStatementStatsMap
 
-	Replication   PostgresReplication
-	Settings      []PostgresSetting
-	BackendCounts []PostgresBackendCount
+	Replication       PostgresReplication
+	SettingFileErrors []PostgresSettingFileError
+	BackendCounts     []PostgresBackendCount
 
 	Version PostgresVersion
 
@@ -138,6 +160,10 @@ type DiffState struct {
 	SystemDiskStats    DiffedDiskStatsMap
 
 	CollectorStats DiffedCollectorStats
+
+	SettingChanges []PostgresSettingChange
+	SchemaChanges  []PostgresSchemaChange
+	HARoleChanges  []HARoleChange // Role changes of a high availability cluster since the last full snapshot
 }
 
 // StateOnDiskFormatVersion - Increment this when an old state preserved to disk should be ignored
@@ -147,6 +173,10 @@ type StateOnDisk struct {
 	FormatVersion uint
 
 	PrevStateByServer map[config.ServerIdentifier]PersistedState
+
+	// High availability clusters are also keyed by their database system identifier, which
+	// stays the same when the section (or the members) of the cluster are changed
+	PrevStateBySystemIdentifier map[string]PersistedState
 }
 
 type CollectionOpts struct {
@@ -170,7 +200,16 @@ type CollectionOpts struct {
 	SubmitCollectedData bool
 	TestRun             bool
 	TestReport          string
+	LocalReport         string
+	LocalReportSection  string
+	LocalReportFormat   string
+	CompareSettings     string
 	TestRunLogs         bool
+
+	SetupMonitoringUser bool
+	CheckPermissions    bool
+	SuperuserUsername   string
+	SuperuserPassword   string
 	DebugLogs           bool
 	DiscoverLogLocation bool
 
@@ -233,6 +272,20 @@ type Server struct {
 	ActivityPrevState  PersistedActivityState
 	ActivityStateMutex *sync.Mutex
 
+	QueryPlans      map[QueryPlanKey]*QueryPlanHistory
+	QueryPlansMutex *sync.Mutex
+
 	CollectionStatus      CollectionStatus
 	CollectionStatusMutex *sync.Mutex
+
+	Health      ServerHealth
+	HealthMutex *sync.Mutex
+
+	HA      HAState
+	HAMutex *sync.Mutex
+
+	// Latest persisted state of each high availability cluster (from the state file, and
+	// updated after each full snapshot), used when the system identifier of the cluster
+	// does not match PrevState (see runner.haPrevState)
+	HAPrevStateBySystemIdentifier map[string]PersistedState
 }
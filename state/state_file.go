@@ -9,10 +9,18 @@ import (
 )
 
 func WriteStateFile(servers []*Server, globalCollectionOpts CollectionOpts, logger *util.Logger) {
-	stateOnDisk := StateOnDisk{PrevStateByServer: make(map[config.ServerIdentifier]PersistedState), FormatVersion: StateOnDiskFormatVersion}
+	stateOnDisk := StateOnDisk{PrevStateByServer: make(map[config.ServerIdentifier]PersistedState), PrevStateBySystemIdentifier: make(map[string]PersistedState), FormatVersion: StateOnDiskFormatVersion}
 
+	for _, server := range servers {
+		for systemIdentifier, prevState := range server.HAPrevStateBySystemIdentifier {
+			stateOnDisk.PrevStateBySystemIdentifier[systemIdentifier] = prevState
+		}
+	}
 	for _, server := range servers {
 		stateOnDisk.PrevStateByServer[server.Config.Identifier] = server.PrevState
+		if server.PrevState.SystemIdentifier != "" {
+			stateOnDisk.PrevStateBySystemIdentifier[server.PrevState.SystemIdentifier] = server.PrevState
+		}
 	}
 
 	file, err := os.Create(globalCollectionOpts.StateFilename)
@@ -49,6 +57,13 @@ func ReadStateFile(servers []*Server, globalCollectionOpts CollectionOpts, logge
 	}
 
 	for idx, server := range servers {
+		if server.Config.HAEnabled() {
+			// The system identifier is only known once connected to the cluster
+			servers[idx].HAPrevStateBySystemIdentifier = make(map[string]PersistedState)
+			for systemIdentifier, prevState := range stateOnDisk.PrevStateBySystemIdentifier {
+				servers[idx].HAPrevStateBySystemIdentifier[systemIdentifier] = prevState
+			}
+		}
 		prevState, exist := stateOnDisk.PrevStateByServer[server.Config.Identifier]
 		if exist {
 			prefixedLogger := logger.WithPrefix(server.Config.SectionName)
@@ -1,28 +1,74 @@
 package util
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"strings"
+	"time"
 )
 
+type LogLevel int
+
+const (
+	LogLevelVerbose LogLevel = iota
+	LogLevelInfo
+	LogLevelWarning
+	LogLevelError
+)
+
+// ParseLogLevel - Parses the value of the --log-level option
+func ParseLogLevel(level string) (LogLevel, error) {
+	switch strings.ToLower(level) {
+	case "verbose", "debug":
+		return LogLevelVerbose, nil
+	case "info":
+		return LogLevelInfo, nil
+	case "warning", "warn":
+		return LogLevelWarning, nil
+	case "error":
+		return LogLevelError, nil
+	}
+	return LogLevelInfo, fmt.Errorf("unsupported log level \"%s\" (supported: verbose, info, warning, error)", level)
+}
+
 type Logger struct {
 	Verbose        bool
 	Quiet          bool
+	MinLevel       LogLevel // Messages below this level are skipped (verbose messages also require Verbose)
+	JSON           bool     // Output one JSON object per message, instead of a line of text
 	Prefix         *string
+	SnapshotType   string // Included in JSON output, e.g. "full" or "activity"
+	Duration       *time.Duration
 	Destination    *log.Logger
 	RememberErrors bool
 	ErrorMessages  []string
 }
 
 func (logger *Logger) WithPrefix(prefix string) *Logger {
-	return &Logger{Verbose: logger.Verbose, Quiet: logger.Quiet, Destination: logger.Destination, Prefix: &prefix}
+	return &Logger{Verbose: logger.Verbose, Quiet: logger.Quiet, MinLevel: logger.MinLevel, JSON: logger.JSON, Destination: logger.Destination, Prefix: &prefix}
 }
 
 func (logger *Logger) WithPrefixAndRememberErrors(prefix string) *Logger {
-	return &Logger{Verbose: logger.Verbose, Quiet: logger.Quiet, Destination: logger.Destination, Prefix: &prefix, RememberErrors: true}
+	return &Logger{Verbose: logger.Verbose, Quiet: logger.Quiet, MinLevel: logger.MinLevel, JSON: logger.JSON, Destination: logger.Destination, Prefix: &prefix, RememberErrors: true}
 }
 
+// WithDuration - Returns a logger for reporting how long an operation took, which
+// is included as "duration_ms" in JSON output
+//
+// Errors printed with the returned logger are not remembered.
+func (logger *Logger) WithDuration(duration time.Duration) *Logger {
+	return &Logger{Verbose: logger.Verbose, Quiet: logger.Quiet, MinLevel: logger.MinLevel, JSON: logger.JSON, Destination: logger.Destination, Prefix: logger.Prefix, SnapshotType: logger.SnapshotType, Duration: &duration}
+}
+
+var logLevelNames = map[string]string{"V": "verbose", "I": "info", "W": "warning", "E": "error"}
+
 func (logger *Logger) print(logLevel string, format string, args ...interface{}) {
+	if logger.JSON {
+		logger.printJSON(logLevel, format, args...)
+		return
+	}
+
 	if logger.Prefix != nil {
 		format = fmt.Sprintf("[%s] %s", *logger.Prefix, format)
 	}
@@ -32,8 +78,38 @@ func (logger *Logger) print(logLevel string, format string, args ...interface{})
 	logger.Destination.Printf(format, args...)
 }
 
+func (logger *Logger) printJSON(logLevel string, format string, args ...interface{}) {
+	entry := map[string]interface{}{
+		"time":    time.Now().UTC().Format(time.RFC3339Nano),
+		"level":   logLevelNames[logLevel],
+		"message": fmt.Sprintf(format, args...),
+	}
+	if logger.Prefix != nil {
+		entry["section"] = *logger.Prefix
+	}
+	if logger.SnapshotType != "" {
+		entry["snapshot_type"] = logger.SnapshotType
+	}
+	if logger.Duration != nil {
+		entry["duration_ms"] = float64(*logger.Duration) / float64(time.Millisecond)
+	}
+	for _, arg := range args {
+		if err, ok := arg.(error); ok {
+			entry["error"] = err.Error()
+			break
+		}
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		logger.Destination.Printf("{\"level\":\"error\",\"message\":%q}", fmt.Sprintf("Could not marshal log message: %s", err))
+		return
+	}
+	logger.Destination.Print(string(line))
+}
+
 func (logger *Logger) PrintVerbose(format string, args ...interface{}) {
-	if logger.Quiet || !logger.Verbose {
+	if logger.Quiet || !logger.Verbose || logger.MinLevel > LogLevelVerbose {
 		return
 	}
 
@@ -41,7 +117,7 @@ func (logger *Logger) PrintVerbose(format string, args ...interface{}) {
 }
 
 func (logger *Logger) PrintInfo(format string, args ...interface{}) {
-	if logger.Quiet {
+	if logger.Quiet || logger.MinLevel > LogLevelInfo {
 		return
 	}
 
@@ -49,6 +125,10 @@ func (logger *Logger) PrintInfo(format string, args ...interface{}) {
 }
 
 func (logger *Logger) PrintWarning(format string, args ...interface{}) {
+	if logger.MinLevel > LogLevelWarning {
+		return
+	}
+
 	logger.print("W", format, args...)
 }
 
@@ -0,0 +1,79 @@
+package util_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pganalyze/collector/util"
+)
+
+func TestLoggerJSON(t *testing.T) {
+	var out bytes.Buffer
+	logger := &util.Logger{JSON: true, Destination: log.New(&out, "", 0)}
+
+	prefixedLogger := logger.WithPrefixAndRememberErrors("server1")
+	prefixedLogger.SnapshotType = "full"
+	prefixedLogger.PrintError("Could not process server: %s", errors.New("connection refused"))
+	prefixedLogger.WithDuration(1500 * time.Millisecond).PrintInfo("Done")
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 log lines, got %d: %s", len(lines), out.String())
+	}
+
+	var entries []map[string]interface{}
+	for _, line := range lines {
+		var entry map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("Could not parse log line %q: %s", line, err)
+		}
+		if _, err := time.Parse(time.RFC3339Nano, entry["time"].(string)); err != nil {
+			t.Errorf("Unexpected time in log line %q: %s", line, err)
+		}
+		delete(entry, "time")
+		entries = append(entries, entry)
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"level":         "error",
+			"message":       "Could not process server: connection refused",
+			"section":       "server1",
+			"snapshot_type": "full",
+			"error":         "connection refused",
+		},
+		{
+			"level":         "info",
+			"message":       "Done",
+			"section":       "server1",
+			"snapshot_type": "full",
+			"duration_ms":   1500.0,
+		},
+	}
+	if diff := pretty.Compare(expected, entries); diff != "" {
+		t.Errorf("Unexpected JSON log output, diff: (-want +got)\n%s", diff)
+	}
+	if len(prefixedLogger.ErrorMessages) != 1 {
+		t.Errorf("Expected error message to be remembered, got %v", prefixedLogger.ErrorMessages)
+	}
+}
+
+func TestLoggerMinLevel(t *testing.T) {
+	var out bytes.Buffer
+	logger := &util.Logger{Verbose: true, MinLevel: util.LogLevelWarning, Destination: log.New(&out, "", 0)}
+
+	logger.PrintVerbose("verbose")
+	logger.PrintInfo("info")
+	logger.PrintWarning("warning")
+	logger.PrintError("error")
+
+	if out.String() != "W warning\nE error\n" {
+		t.Errorf("Expected only warnings and errors, got %q", out.String())
+	}
+}
@@ -2,6 +2,10 @@ package util
 
 import pg_query "github.com/lfittl/pg_query_go"
 
+// FilterQueryTextNormalize - Replace literals with $n placeholders in all query
+// texts (statement texts, query samples and activity snapshots) before upload
+const FilterQueryTextNormalize = "normalize"
+
 func NormalizeQuery(query string, filterQueryText string) string {
 	normalizedQuery, err := pg_query.Normalize(query)
 	if err != nil {
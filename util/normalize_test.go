@@ -0,0 +1,48 @@
+package util_test
+
+import (
+	"testing"
+
+	"github.com/pganalyze/collector/util"
+)
+
+var normalizeTests = []struct {
+	input           string
+	filterQueryText string
+	expected        string
+}{
+	{
+		"SELECT * FROM x WHERE id = 123 AND name = 'secret'",
+		"normalize",
+		"SELECT * FROM x WHERE id = $1 AND name = $2",
+	},
+	{
+		"SELECT * FROM x WHERE id = $1",
+		"normalize",
+		"SELECT * FROM x WHERE id = $1",
+	},
+	{
+		"SELECT * FROM x WHERE name = 'secret",
+		"normalize",
+		"<unparsable query>",
+	},
+	{
+		"SELECT * FROM x WHERE name = 'secret",
+		"unparsable",
+		"<unparsable query>",
+	},
+	{
+		"SELECT * FROM x WHERE name = 'secret",
+		"none",
+		"SELECT * FROM x WHERE name = 'secret",
+	},
+}
+
+func TestNormalizeQuery(t *testing.T) {
+	for _, test := range normalizeTests {
+		actual := util.NormalizeQuery(test.input, test.filterQueryText)
+		if actual != test.expected {
+			t.Errorf("\nNormalizeQuery(%q, %q)\n expected %q\n actual   %q\n\n", test.input, test.filterQueryText, test.expected, actual)
+		}
+	}
+}